@@ -0,0 +1,137 @@
+package pandadoc
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+const apiLogsPageSize = 100
+
+// apiLogsService implements APILogsService.
+type apiLogsService struct {
+	client *Client
+}
+
+// List lists v1 API logs.
+func (s *apiLogsService) List(ctx context.Context, opts *ListAPILogsOptions) (*APILogListResponse, error) {
+	return s.list(ctx, "/public/v1/logs", opts)
+}
+
+// Get returns v1 API log details.
+func (s *apiLogsService) Get(ctx context.Context, id string) (*APILogDetails, error) {
+	return s.get(ctx, "/public/v1/logs/", id)
+}
+
+// All iterates over every v1 API log matching opts, fetching pages as needed.
+//
+// opts.Count sets the page size (default 100) and opts.Page the first page.
+// Iteration stops at the first error, which is yielded once.
+func (s *apiLogsService) All(ctx context.Context, opts *ListAPILogsOptions) iter.Seq2[APILogEntry, error] {
+	return s.all(ctx, "/public/v1/logs", opts)
+}
+
+// ListV2 lists v2 API logs.
+func (s *apiLogsService) ListV2(ctx context.Context, opts *ListAPILogsOptions) (*APILogListResponse, error) {
+	return s.list(ctx, "/public/v2/logs", opts)
+}
+
+// GetV2 returns v2 API log details.
+func (s *apiLogsService) GetV2(ctx context.Context, id string) (*APILogDetails, error) {
+	return s.get(ctx, "/public/v2/logs/", id)
+}
+
+// AllV2 iterates over every v2 API log matching opts, fetching pages as needed.
+//
+// opts.Count sets the page size (default 100) and opts.Page the first page.
+// Iteration stops at the first error, which is yielded once.
+func (s *apiLogsService) AllV2(ctx context.Context, opts *ListAPILogsOptions) iter.Seq2[APILogEntry, error] {
+	return s.all(ctx, "/public/v2/logs", opts)
+}
+
+func (s *apiLogsService) list(ctx context.Context, path string, opts *ListAPILogsOptions) (*APILogListResponse, error) {
+	query := url.Values{}
+	if opts != nil {
+		if !opts.Since.IsZero() {
+			query.Set("since", opts.Since.UTC().Format(time.RFC3339))
+		}
+		if !opts.To.IsZero() {
+			query.Set("to", opts.To.UTC().Format(time.RFC3339))
+		}
+		setIfPositive(query, "count", opts.Count)
+		setIfPositive(query, "page", opts.Page)
+		for _, status := range opts.Statuses {
+			query.Add("statuses", strconv.Itoa(int(status)))
+		}
+		for _, method := range opts.Methods {
+			query.Add("methods", string(method))
+		}
+		setIfNotEmpty(query, "search", opts.Endpoint)
+		setIfNotEmpty(query, "environment_type", string(opts.Environment))
+	}
+
+	var out APILogListResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        path,
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (s *apiLogsService) get(ctx context.Context, prefix, id string) (*APILogDetails, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var out APILogDetails
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        prefix + escapedID,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (s *apiLogsService) all(ctx context.Context, path string, opts *ListAPILogsOptions) iter.Seq2[APILogEntry, error] {
+	page := ListAPILogsOptions{}
+	if opts != nil {
+		page = *opts
+	}
+	if page.Count <= 0 {
+		page.Count = apiLogsPageSize
+	}
+	if page.Page <= 0 {
+		page.Page = 1
+	}
+
+	return func(yield func(APILogEntry, error) bool) {
+		for {
+			resp, err := s.list(ctx, path, &page)
+			if err != nil {
+				yield(APILogEntry{}, err)
+				return
+			}
+			for _, entry := range resp.Results {
+				if !yield(entry, nil) {
+					return
+				}
+			}
+			if len(resp.Results) < page.Count {
+				return
+			}
+			page.Page++
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package pandadoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestAPILogsService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	const details = `{"id":"log1","method":"POST","url":"/public/v1/documents/d1/send","status":400,"request_id":"req-1","application":null,
+		"request_time":"2024-07-15T18:59:38.000","response_time":"2024-07-15T18:59:39.250","token_type":"API_KEY","user_email":"a@example.com",
+		"query_params_object":null,"request_body":{"silent":true},"response_body":{"type":"request_error"}}`
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/public/v1/logs", "/public/v2/logs":
+			q := r.URL.Query()
+			assertQueryEq(t, q, "since", "2024-07-01T00:00:00Z")
+			assertQueryEq(t, q, "to", "2024-07-02T00:00:00Z")
+			assertQueryEq(t, q, "search", "documents/d1")
+			assertQueryEq(t, q, "environment_type", "SANDBOX")
+			assertQueryEq(t, q, "count", "5")
+			if got := q["statuses"]; len(got) != 2 || got[0] != "400" || got[1] != "500" {
+				t.Fatalf("unexpected statuses: %v", got)
+			}
+			if got := q["methods"]; len(got) != 1 || got[0] != http.MethodPost {
+				t.Fatalf("unexpected methods: %v", got)
+			}
+			_, _ = io.WriteString(w, `{"results":[{"id":"log1","method":"POST","url":"/public/v1/documents/d1/send","status":400,"request_time":"2024-07-15T18:59:38.000"}]}`)
+		case "/public/v1/logs/log1", "/public/v2/logs/log1":
+			_, _ = io.WriteString(w, details)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.APILogs()
+	since := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	opts := &ListAPILogsOptions{
+		Since:       since,
+		To:          since.Add(24 * time.Hour),
+		Count:       5,
+		Statuses:    []APILogStatusClass{APILogStatus4xx, APILogStatus5xx},
+		Methods:     []APILogMethod{APILogMethodPost},
+		Endpoint:    "documents/d1",
+		Environment: APILogEnvironmentSandbox,
+	}
+
+	for name, list := range map[string]func(context.Context, *ListAPILogsOptions) (*APILogListResponse, error){"v1": svc.List, "v2": svc.ListV2} {
+		resp, err := list(ctx, opts)
+		if err != nil || len(resp.Results) != 1 || resp.Results[0].Status != 400 {
+			t.Fatalf("%s list failed: %v %+v", name, err, resp)
+		}
+		at, ok := resp.Results[0].RequestedAt()
+		if !ok || !at.Equal(time.Date(2024, 7, 15, 18, 59, 38, 0, time.UTC)) {
+			t.Fatalf("%s unexpected request time: %v %v", name, at, ok)
+		}
+	}
+
+	for name, get := range map[string]func(context.Context, string) (*APILogDetails, error){"v1": svc.Get, "v2": svc.GetV2} {
+		log, err := get(ctx, "log1")
+		if err != nil || log.RequestID != "req-1" || log.Method != http.MethodPost || log.Application != nil || string(log.RequestBody) != `{"silent":true}` {
+			t.Fatalf("%s get failed: %v %+v", name, err, log)
+		}
+		if done, ok := log.RespondedAt(); !ok || done.Nanosecond() != 250*int(time.Millisecond) {
+			t.Fatalf("%s unexpected response time: %v %v", name, done, ok)
+		}
+	}
+
+	if _, err := svc.Get(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty id error, got %v", err)
+	}
+	if _, ok := (APILogEntry{RequestTime: "yesterday"}).RequestedAt(); ok {
+		t.Fatalf("expected unparsable time")
+	}
+}
+
+func TestAPILogsService_AllPaginates(t *testing.T) {
+	t.Parallel()
+
+	var pages []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/public/v2/logs" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		assertQueryEq(t, r.URL.Query(), "count", "2")
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		switch page {
+		case "1":
+			_, _ = io.WriteString(w, `{"results":[{"id":"a"},{"id":"b"}]}`)
+		case "2":
+			_, _ = io.WriteString(w, `{"results":[{"id":"c"}]}`)
+		default:
+			t.Fatalf("unexpected page %s", page)
+		}
+	})
+
+	var ids []string
+	for entry, err := range client.APILogs().AllV2(context.Background(), &ListAPILogsOptions{Count: 2}) {
+		if err != nil {
+			t.Fatalf("AllV2 failed: %v", err)
+		}
+		ids = append(ids, entry.ID)
+	}
+	if fmt.Sprint(ids) != "[a b c]" || fmt.Sprint(pages) != "[1 2]" {
+		t.Fatalf("unexpected iteration: ids=%v pages=%v", ids, pages)
+	}
+
+	for entry := range client.APILogs().AllV2(context.Background(), &ListAPILogsOptions{Count: 2}) {
+		if entry.ID != "a" {
+			t.Fatalf("unexpected first entry: %+v", entry)
+		}
+		break
+	}
+	if len(pages) != 3 {
+		t.Fatalf("expected early break to stop paging, got pages=%v", pages)
+	}
+}
+
+func TestAPILogsService_AllYieldsError(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = r
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = io.WriteString(w, `{"detail":"forbidden"}`)
+	})
+
+	calls := 0
+	for _, err := range client.APILogs().All(context.Background(), nil) {
+		calls++
+		if !IsForbidden(err) {
+			t.Fatalf("expected forbidden error, got %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected error to be yielded once, got %d", calls)
+	}
+}
+
+func TestAPILogDetails_MatchesError(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/public/v1/documents/d1/send":
+			w.Header().Set("X-Request-Id", "req-42")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"type":"request_error","detail":"Document is not in draft status"}`)
+		case "/public/v2/logs/log42":
+			_, _ = io.WriteString(w, `{"id":"log42","request_id":"req-42","status":`+strconv.Itoa(http.StatusBadRequest)+`}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	_, sendErr := client.Documents().Send(ctx, "d1", DocumentSendRequest{})
+	if sendErr == nil {
+		t.Fatalf("expected send error")
+	}
+
+	log, err := client.APILogs().GetV2(ctx, "log42")
+	if err != nil {
+		t.Fatalf("GetV2 failed: %v", err)
+	}
+	if !log.MatchesError(fmt.Errorf("wrapped: %w", sendErr)) {
+		t.Fatalf("expected log to match API error request id")
+	}
+	if log.MatchesError(errTestDummy) || (&APILogDetails{}).MatchesError(sendErr) {
+		t.Fatalf("expected non-matching errors to be rejected")
+	}
+	var missing *APILogDetails
+	if missing.MatchesError(sendErr) {
+		t.Fatalf("expected nil details not to match")
+	}
+}
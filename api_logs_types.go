@@ -0,0 +1,126 @@
+package pandadoc
+
+import (
+	"errors"
+	"time"
+)
+
+// APILogStatusClass filters API logs by HTTP status class.
+type APILogStatusClass int
+
+// Supported API log status classes.
+const (
+	// APILogStatus1xx matches informational responses.
+	APILogStatus1xx APILogStatusClass = 100
+	// APILogStatus2xx matches successful responses.
+	APILogStatus2xx APILogStatusClass = 200
+	// APILogStatus3xx matches redirect responses.
+	APILogStatus3xx APILogStatusClass = 300
+	// APILogStatus4xx matches client error responses.
+	APILogStatus4xx APILogStatusClass = 400
+	// APILogStatus5xx matches server error responses.
+	APILogStatus5xx APILogStatusClass = 500
+)
+
+// APILogEnvironment selects production or sandbox API logs.
+type APILogEnvironment string
+
+// Supported API log environments.
+const (
+	// APILogEnvironmentProduction selects logs of production API keys.
+	APILogEnvironmentProduction APILogEnvironment = "PRODUCTION"
+	// APILogEnvironmentSandbox selects logs of sandbox API keys.
+	APILogEnvironmentSandbox APILogEnvironment = "SANDBOX"
+)
+
+// APILogMethod filters API logs by HTTP request method.
+type APILogMethod string
+
+// Supported API log request methods.
+const (
+	// APILogMethodGet matches GET requests.
+	APILogMethodGet APILogMethod = "GET"
+	// APILogMethodPost matches POST requests.
+	APILogMethodPost APILogMethod = "POST"
+	// APILogMethodPut matches PUT requests.
+	APILogMethodPut APILogMethod = "PUT"
+	// APILogMethodPatch matches PATCH requests.
+	APILogMethodPatch APILogMethod = "PATCH"
+	// APILogMethodDelete matches DELETE requests.
+	APILogMethodDelete APILogMethod = "DELETE"
+)
+
+// ListAPILogsOptions controls list API logs query params.
+//
+// Zero Since and To fall back to PandaDoc's defaults: logs from the last 90
+// days up to now.
+// Endpoint is matched as a substring of the logged request URL.
+type ListAPILogsOptions struct {
+	Since       time.Time
+	To          time.Time
+	Count       int
+	Page        int
+	Statuses    []APILogStatusClass
+	Methods     []APILogMethod
+	Endpoint    string
+	Environment APILogEnvironment
+}
+
+// APILogEntry models one API log in a list response.
+type APILogEntry struct {
+	ID           string `json:"id"`
+	Method       string `json:"method,omitempty"`
+	URL          string `json:"url,omitempty"`
+	Status       int    `json:"status"`
+	RequestTime  string `json:"request_time,omitempty"`
+	ResponseTime string `json:"response_time,omitempty"`
+}
+
+// RequestedAt parses RequestTime, which PandaDoc reports in UTC.
+func (e APILogEntry) RequestedAt() (time.Time, bool) {
+	return parseAPILogTime(e.RequestTime)
+}
+
+// RespondedAt parses ResponseTime, which PandaDoc reports in UTC.
+func (e APILogEntry) RespondedAt() (time.Time, bool) {
+	return parseAPILogTime(e.ResponseTime)
+}
+
+// APILogListResponse models list API logs response.
+type APILogListResponse struct {
+	Results []APILogEntry `json:"results"`
+}
+
+// APILogDetails models a single API log with request and response payloads.
+type APILogDetails struct {
+	APILogEntry
+
+	RequestID         string  `json:"request_id,omitempty"`
+	Application       *string `json:"application,omitempty"`
+	Key               string  `json:"key,omitempty"`
+	TokenType         string  `json:"token_type,omitempty"`
+	UserID            string  `json:"user_id,omitempty"`
+	UserEmail         string  `json:"user_email,omitempty"`
+	QueryParamsObject RawJSON `json:"query_params_object,omitempty"`
+	QueryParamsString *string `json:"query_params_string,omitempty"`
+	RequestBody       RawJSON `json:"request_body,omitempty"`
+	ResponseBody      RawJSON `json:"response_body,omitempty"`
+}
+
+// MatchesError reports whether err is an APIError for the request this log describes.
+func (d *APILogDetails) MatchesError(err error) bool {
+	var apiErr *APIError
+	if d == nil || d.RequestID == "" || !errors.As(err, &apiErr) {
+		return false
+	}
+	return apiErr.RequestID == d.RequestID
+}
+
+func parseAPILogTime(value string) (time.Time, bool) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999"} {
+		if t, err := time.ParseInLocation(layout, value, time.UTC); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,80 @@
+package pandadoc
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// Backoff computes the delay before a retry or the next poll.
+type Backoff interface {
+	// Delay returns the wait after attempt (0-based). previous is the delay
+	// this Backoff returned for the prior attempt of the same call, or zero.
+	Delay(attempt int, previous time.Duration) time.Duration
+}
+
+// BackoffFunc adapts a function to the Backoff interface.
+type BackoffFunc func(attempt int, previous time.Duration) time.Duration
+
+// Delay calls f.
+func (f BackoffFunc) Delay(attempt int, previous time.Duration) time.Duration {
+	return f(attempt, previous)
+}
+
+// ExponentialBackoff doubles the delay from base on every attempt, up to maxDelay.
+func ExponentialBackoff(base, maxDelay time.Duration) Backoff {
+	return BackoffFunc(func(attempt int, _ time.Duration) time.Duration {
+		return exponentialDelay(base, maxDelay, attempt)
+	})
+}
+
+// FullJitterBackoff waits a random duration between zero and the exponential
+// delay, so clients that failed together retry at different moments.
+func FullJitterBackoff(base, maxDelay time.Duration) Backoff {
+	return BackoffFunc(func(attempt int, _ time.Duration) time.Duration {
+		return randomBetween(0, exponentialDelay(base, maxDelay, attempt))
+	})
+}
+
+// DecorrelatedJitterBackoff waits a random duration between base and three
+// times the previous delay, capped at maxDelay.
+func DecorrelatedJitterBackoff(base, maxDelay time.Duration) Backoff {
+	return BackoffFunc(func(_ int, previous time.Duration) time.Duration {
+		previous = max(previous, base)
+		return min(maxDelay, randomBetween(base, 3*previous))
+	})
+}
+
+// ConstantBackoff always waits delay.
+func ConstantBackoff(delay time.Duration) Backoff {
+	return BackoffFunc(func(int, time.Duration) time.Duration {
+		return delay
+	})
+}
+
+func exponentialDelay(base, maxDelay time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 0; i < attempt; i++ {
+		delay *= 2
+		if delay >= maxDelay {
+			return maxDelay
+		}
+	}
+	return min(delay, maxDelay)
+}
+
+// randomBetween returns a uniformly random duration in [lo, hi].
+func randomBetween(lo, hi time.Duration) time.Duration {
+	if hi <= lo {
+		return lo
+	}
+	return lo + time.Duration(rand.Int64N(int64(hi-lo)+1)) //nolint:gosec // jitter does not need a cryptographic source
+}
+
+// retryDelay returns the next delay from the policy's Backoff, falling back to
+// full jitter over the policy's InitialBackoff and MaxBackoff.
+func (c *Client) retryDelay(attempt int, previous time.Duration) time.Duration {
+	if c.retryPolicy.Backoff == nil {
+		return randomBetween(0, c.backoff(attempt))
+	}
+	return max(0, c.retryPolicy.Backoff.Delay(attempt, previous))
+}
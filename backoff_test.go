@@ -0,0 +1,103 @@
+package pandadoc
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBackoffStrategies(t *testing.T) {
+	t.Parallel()
+
+	exp := ExponentialBackoff(100*time.Millisecond, time.Second)
+	for attempt, want := range []time.Duration{100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second} {
+		if got := exp.Delay(attempt, 0); got != want {
+			t.Fatalf("exponential attempt %d: expected %v, got %v", attempt, want, got)
+		}
+	}
+
+	full := FullJitterBackoff(100*time.Millisecond, time.Second)
+	seen := map[time.Duration]bool{}
+	for range 200 {
+		d := full.Delay(3, 0)
+		if d < 0 || d > 800*time.Millisecond {
+			t.Fatalf("full jitter out of range: %v", d)
+		}
+		seen[d] = true
+	}
+	if len(seen) < 2 {
+		t.Fatalf("expected full jitter to vary")
+	}
+
+	decorrelated := DecorrelatedJitterBackoff(100*time.Millisecond, time.Second)
+	previous := time.Duration(0)
+	for range 200 {
+		upper := min(time.Second, 3*max(previous, 100*time.Millisecond))
+		d := decorrelated.Delay(0, previous)
+		if d < 100*time.Millisecond || d > upper {
+			t.Fatalf("decorrelated jitter %v outside [100ms, %v]", d, upper)
+		}
+		previous = d
+	}
+
+	if d := ConstantBackoff(time.Second).Delay(7, time.Minute); d != time.Second {
+		t.Fatalf("expected constant delay, got %v", d)
+	}
+	if d := randomBetween(time.Second, time.Second); d != time.Second {
+		t.Fatalf("expected degenerate range to return its bound, got %v", d)
+	}
+}
+
+func TestRetryDelay_UsesPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClientWithAPIKey("k", WithRetryPolicy(RetryPolicy{InitialBackoff: time.Second, MaxBackoff: 4 * time.Second}))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	for range 50 {
+		if d := c.retryDelay(2, 0); d < 0 || d > 4*time.Second {
+			t.Fatalf("expected a jittered fallback capped at 4s, got %v", d)
+		}
+	}
+
+	c.retryPolicy.Backoff = BackoffFunc(func(attempt int, previous time.Duration) time.Duration {
+		return previous - time.Duration(attempt)
+	})
+	if d := c.retryDelay(1, 5); d != 4 {
+		t.Fatalf("expected custom backoff, got %v", d)
+	}
+	if d := c.retryDelay(1, 0); d != 0 {
+		t.Fatalf("expected negative delays to be clamped, got %v", d)
+	}
+}
+
+func TestRetryDelay_DefaultPolicyFollowsBackoffFields(t *testing.T) {
+	t.Parallel()
+
+	policy := DefaultRetryPolicy()
+	if policy.Backoff != nil {
+		t.Fatalf("expected the default policy to leave Backoff nil")
+	}
+	policy.InitialBackoff = 10 * time.Millisecond
+	policy.MaxBackoff = 40 * time.Millisecond
+
+	c, err := NewClientWithAPIKey("k", WithRetryPolicy(policy))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+
+	seen := map[time.Duration]bool{}
+	for range 200 {
+		first, later := c.retryDelay(0, 0), c.retryDelay(5, 0)
+		if first < 0 || first > 10*time.Millisecond {
+			t.Fatalf("expected the first delay within InitialBackoff, got %v", first)
+		}
+		if later < 0 || later > 40*time.Millisecond {
+			t.Fatalf("expected later delays capped at MaxBackoff, got %v", later)
+		}
+		seen[later] = true
+	}
+	if len(seen) < 2 {
+		t.Fatalf("expected jittered delays, got %v", seen)
+	}
+}
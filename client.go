@@ -30,12 +30,37 @@ type Client struct {
 	apiKey      string
 	accessToken string
 	logger      Logger
+	middleware  []Middleware
+	rateLimiter *rateLimiter
+	retryBudget *retryBudget
 
 	documents            DocumentsService
 	productCatalog       ProductCatalogService
 	oauth                OAuthService
 	webhookSubscriptions WebhookSubscriptionsService
 	webhookEvents        WebhookEventsService
+	contacts             ContactsService
+	templates            TemplatesService
+	folders              FoldersService
+	documentRecipients   DocumentRecipientsService
+	documentAttachments  DocumentAttachmentsService
+	documentFields       DocumentFieldsService
+	documentSections     DocumentSectionsService
+	documentReminders    DocumentRemindersService
+	settings             SettingsService
+	contentLibrary       ContentLibraryService
+	linkedObjects        LinkedObjectsService
+	quotes               QuotesService
+	members              MembersService
+	workspaces           WorkspacesService
+	users                UsersService
+	apiLogs              APILogsService
+	notary               NotaryService
+	forms                FormsService
+	smsOptOuts           SMSOptOutsService
+	documentStructure    DocumentStructureService
+	documentAuditTrail   DocumentAuditTrailService
+	documentDocxExport   DocumentDocxExportService
 }
 
 // NewClient creates a new PandaDoc client.
@@ -78,13 +103,12 @@ func NewClient(opts ...Option) (*Client, error) {
 		apiKey:      cfg.apiKey,
 		accessToken: cfg.accessToken,
 		logger:      cfg.logger,
+		middleware:  cfg.middleware,
+		rateLimiter: cfg.rateLimiter,
+		retryBudget: cfg.retryBudget,
 	}
 
-	client.documents = &documentsService{client: client}
-	client.productCatalog = &productCatalogService{client: client}
-	client.oauth = &oauthService{client: client}
-	client.webhookSubscriptions = &webhookSubscriptionsService{client: client}
-	client.webhookEvents = &webhookEventsService{client: client}
+	client.initServices()
 
 	return client, nil
 }
@@ -99,6 +123,56 @@ func NewClientWithAccessToken(token string, opts ...Option) (*Client, error) {
 	return NewClient(append([]Option{WithAccessToken(token)}, opts...)...)
 }
 
+// AsMember returns a copy of the client that acts as a workspace member.
+//
+// The token comes from Members().CreateToken and is sent with Bearer auth.
+// Transport settings such as base URL, HTTP client, retries, logger, rate
+// limiter, and retry budget are shared with the original client.
+func (c *Client) AsMember(token string) (*Client, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return nil, ErrMissingAuthentication
+	}
+
+	member := *c
+	member.apiKey = ""
+	member.accessToken = token
+	member.initServices()
+
+	return &member, nil
+}
+
+// initServices binds every service to the client.
+func (c *Client) initServices() {
+	c.documents = &documentsService{client: c}
+	c.productCatalog = &productCatalogService{client: c}
+	c.oauth = &oauthService{client: c}
+	c.webhookSubscriptions = &webhookSubscriptionsService{client: c}
+	c.webhookEvents = &webhookEventsService{client: c}
+	c.contacts = &contactsService{client: c}
+	c.templates = &templatesService{client: c}
+	c.folders = &foldersService{client: c}
+	c.documentRecipients = &documentRecipientsService{client: c}
+	c.documentAttachments = &documentAttachmentsService{client: c}
+	c.documentFields = &documentFieldsService{client: c}
+	c.documentSections = &documentSectionsService{client: c}
+	c.documentReminders = &documentRemindersService{client: c}
+	c.settings = &settingsService{client: c}
+	c.contentLibrary = &contentLibraryService{client: c}
+	c.linkedObjects = &linkedObjectsService{client: c}
+	c.quotes = &quotesService{client: c}
+	c.members = &membersService{client: c}
+	c.workspaces = &workspacesService{client: c}
+	c.users = &usersService{client: c}
+	c.apiLogs = &apiLogsService{client: c}
+	c.notary = &notaryService{client: c}
+	c.forms = &formsService{client: c}
+	c.smsOptOuts = &smsOptOutsService{client: c}
+	c.documentStructure = &documentStructureService{client: c}
+	c.documentAuditTrail = &documentAuditTrailService{client: c}
+	c.documentDocxExport = &documentDocxExportService{client: c}
+}
+
 // Documents exposes document-related endpoints.
 func (c *Client) Documents() DocumentsService {
 	return c.documents
@@ -124,6 +198,116 @@ func (c *Client) WebhookEvents() WebhookEventsService {
 	return c.webhookEvents
 }
 
+// Contacts exposes contact endpoints.
+func (c *Client) Contacts() ContactsService {
+	return c.contacts
+}
+
+// Templates exposes template endpoints.
+func (c *Client) Templates() TemplatesService {
+	return c.templates
+}
+
+// Folders exposes document and template folder endpoints.
+func (c *Client) Folders() FoldersService {
+	return c.folders
+}
+
+// DocumentRecipients exposes document recipient endpoints.
+func (c *Client) DocumentRecipients() DocumentRecipientsService {
+	return c.documentRecipients
+}
+
+// DocumentAttachments exposes document attachment endpoints.
+func (c *Client) DocumentAttachments() DocumentAttachmentsService {
+	return c.documentAttachments
+}
+
+// DocumentFields exposes document field endpoints.
+func (c *Client) DocumentFields() DocumentFieldsService {
+	return c.documentFields
+}
+
+// DocumentSections exposes document section (bundle) endpoints.
+func (c *Client) DocumentSections() DocumentSectionsService {
+	return c.documentSections
+}
+
+// DocumentReminders exposes document reminder endpoints.
+func (c *Client) DocumentReminders() DocumentRemindersService {
+	return c.documentReminders
+}
+
+// Settings exposes v2 document and template settings endpoints.
+func (c *Client) Settings() SettingsService {
+	return c.settings
+}
+
+// ContentLibrary exposes content library item endpoints.
+func (c *Client) ContentLibrary() ContentLibraryService {
+	return c.contentLibrary
+}
+
+// LinkedObjects exposes CRM linked-object endpoints.
+func (c *Client) LinkedObjects() LinkedObjectsService {
+	return c.linkedObjects
+}
+
+// Quotes exposes document quote endpoints.
+func (c *Client) Quotes() QuotesService {
+	return c.quotes
+}
+
+// Members exposes workspace member endpoints.
+func (c *Client) Members() MembersService {
+	return c.members
+}
+
+// Workspaces exposes organization workspace endpoints.
+func (c *Client) Workspaces() WorkspacesService {
+	return c.workspaces
+}
+
+// Users exposes organization user endpoints.
+func (c *Client) Users() UsersService {
+	return c.users
+}
+
+// APILogs exposes v1 and v2 API log endpoints.
+func (c *Client) APILogs() APILogsService {
+	return c.apiLogs
+}
+
+// Notary exposes v2 notary and notarization request endpoints.
+func (c *Client) Notary() NotaryService {
+	return c.notary
+}
+
+// Forms exposes form endpoints.
+func (c *Client) Forms() FormsService {
+	return c.forms
+}
+
+// SMSOptOuts exposes SMS opt-out endpoints.
+func (c *Client) SMSOptOuts() SMSOptOutsService {
+	return c.smsOptOuts
+}
+
+// DocumentStructure exposes Document Structure View (DSV) endpoints.
+func (c *Client) DocumentStructure() DocumentStructureService {
+	return c.documentStructure
+}
+
+// DocumentAuditTrail exposes v2 document audit trail endpoints.
+func (c *Client) DocumentAuditTrail() DocumentAuditTrailService {
+	return c.documentAuditTrail
+}
+
+// DocumentDocxExport exposes beta DOCX export endpoints.
+func (c *Client) DocumentDocxExport() DocumentDocxExportService {
+	return c.documentDocxExport
+}
+
 func normalizeBaseURL(raw string) (*url.URL, error) {
 	raw = strings.TrimSpace(raw)
 	if raw == "" {
@@ -13,7 +13,23 @@ func TestNewClient_ConstructorsAndServices(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewClient failed: %v", err)
 	}
-	if c.Documents() == nil || c.ProductCatalog() == nil || c.OAuth() == nil || c.WebhookSubscriptions() == nil || c.WebhookEvents() == nil {
+	if c.Documents() == nil || c.ProductCatalog() == nil || c.OAuth() == nil || c.WebhookSubscriptions() == nil || c.WebhookEvents() == nil ||
+		c.Contacts() == nil || c.Templates() == nil || c.Folders() == nil ||
+		c.DocumentRecipients() == nil || c.DocumentAttachments() == nil ||
+		c.DocumentFields() == nil || c.DocumentSections() == nil ||
+		c.DocumentReminders() == nil || c.Settings() == nil ||
+		c.ContentLibrary() == nil || c.LinkedObjects() == nil ||
+		c.Quotes() == nil ||
+		c.Members() == nil ||
+		c.Workspaces() == nil ||
+		c.Users() == nil ||
+		c.APILogs() == nil ||
+		c.Notary() == nil ||
+		c.Forms() == nil ||
+		c.SMSOptOuts() == nil ||
+		c.DocumentStructure() == nil ||
+		c.DocumentAuditTrail() == nil ||
+		c.DocumentDocxExport() == nil {
 		t.Fatal("expected all service accessors to be initialized")
 	}
 	if c.baseURL.String() != "https://api.pandadoc.com/" {
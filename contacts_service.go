@@ -0,0 +1,109 @@
+package pandadoc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// contactsService implements ContactsService.
+type contactsService struct {
+	client *Client
+}
+
+// List lists contacts, optionally filtered by email.
+func (s *contactsService) List(ctx context.Context, opts *ListContactsOptions) (*ContactListResponse, error) {
+	query := url.Values{}
+	if opts != nil {
+		setIfNotEmpty(query, "email", opts.Email)
+	}
+
+	var out ContactListResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/contacts",
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Create creates a contact.
+func (s *contactsService) Create(ctx context.Context, reqBody *ContactRequest) (*Contact, error) {
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out Contact
+	err := s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPost,
+		path:           "/public/v1/contacts",
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Get gets contact details by ID.
+func (s *contactsService) Get(ctx context.Context, id string) (*Contact, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var out Contact
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/contacts/" + escapedID,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Update updates a contact.
+func (s *contactsService) Update(ctx context.Context, id string, reqBody *ContactRequest) (*Contact, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out Contact
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPatch,
+		path:        "/public/v1/contacts/" + escapedID,
+		requireAuth: true,
+		jsonBody:    reqBody,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete deletes a contact.
+func (s *contactsService) Delete(ctx context.Context, id string) error {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return err
+	}
+
+	return s.client.decodeJSON(ctx, &request{
+		method:         http.MethodDelete,
+		path:           "/public/v1/contacts/" + escapedID,
+		requireAuth:    true,
+		expectedStatus: []int{http.StatusNoContent},
+	}, nil)
+}
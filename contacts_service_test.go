@@ -0,0 +1,169 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestContactsService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/contacts":
+			assertQueryEq(t, r.URL.Query(), "email", "jane@example.com")
+			_, _ = io.WriteString(w, `{"results":[{"id":"c1","email":"jane@example.com","first_name":"Jane"}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/contacts":
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode create payload: %v", err)
+			}
+			if payload["email"] != "jane@example.com" || payload["company"] != "Acme" {
+				t.Fatalf("unexpected create payload: %+v", payload)
+			}
+			if _, ok := payload["phone"]; ok {
+				t.Fatalf("expected empty fields to be omitted: %+v", payload)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id":"c1","email":"jane@example.com","company":"Acme"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/contacts/c1":
+			_, _ = io.WriteString(w, `{"id":"c1","email":"jane@example.com","city":"Austin"}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/public/v1/contacts/c1":
+			_, _ = io.WriteString(w, `{"id":"c1","job_title":"CTO"}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/public/v1/contacts/c1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	list, err := client.Contacts().List(context.Background(), &ListContactsOptions{Email: "jane@example.com"})
+	if err != nil || len(list.Results) != 1 || list.Results[0].FirstName != "Jane" {
+		t.Fatalf("List failed: %v %+v", err, list)
+	}
+
+	created, err := client.Contacts().Create(context.Background(), &ContactRequest{Email: "jane@example.com", Company: "Acme"})
+	if err != nil || created.ID != "c1" || created.Company != "Acme" {
+		t.Fatalf("Create failed: %v %+v", err, created)
+	}
+
+	got, err := client.Contacts().Get(context.Background(), "c1")
+	if err != nil || got.City != "Austin" {
+		t.Fatalf("Get failed: %v %+v", err, got)
+	}
+
+	updated, err := client.Contacts().Update(context.Background(), "c1", &ContactRequest{JobTitle: "CTO"})
+	if err != nil || updated.JobTitle != "CTO" {
+		t.Fatalf("Update failed: %v %+v", err, updated)
+	}
+
+	if err := client.Contacts().Delete(context.Background(), "c1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+}
+
+func TestContactsService_ListNilOptions(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Fatalf("expected no query params, got %s", r.URL.RawQuery)
+		}
+		_, _ = io.WriteString(w, `{"results":[]}`)
+	})
+
+	if _, err := client.Contacts().List(context.Background(), nil); err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+}
+
+func TestContactsService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	if _, err := client.Contacts().Create(context.Background(), nil); err == nil {
+		t.Fatalf("expected nil request")
+	}
+	if _, err := client.Contacts().Get(context.Background(), ""); err == nil {
+		t.Fatalf("expected path param error")
+	}
+	if _, err := client.Contacts().Update(context.Background(), "c1", nil); err == nil {
+		t.Fatalf("expected nil request")
+	}
+	if _, err := client.Contacts().Update(context.Background(), "", &ContactRequest{}); err == nil {
+		t.Fatalf("expected path param error")
+	}
+	if err := client.Contacts().Delete(context.Background(), ""); err == nil {
+		t.Fatalf("expected path param error")
+	}
+}
+
+func TestContactsService_ErrorPropagation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = io.WriteString(w, `{"type":"request_error","detail":"bad contact"}`)
+	})
+
+	type testCase struct {
+		name string
+		run  func() error
+	}
+
+	cases := []testCase{
+		{
+			name: "List",
+			run: func() error {
+				_, err := client.Contacts().List(context.Background(), nil)
+				return err
+			},
+		},
+		{
+			name: "Create",
+			run: func() error {
+				_, err := client.Contacts().Create(context.Background(), &ContactRequest{Email: "x@example.com"})
+				return err
+			},
+		},
+		{
+			name: "Get",
+			run: func() error {
+				_, err := client.Contacts().Get(context.Background(), "c1")
+				return err
+			},
+		},
+		{
+			name: "Update",
+			run: func() error {
+				_, err := client.Contacts().Update(context.Background(), "c1", &ContactRequest{City: "x"})
+				return err
+			},
+		},
+		{
+			name: "Delete",
+			run: func() error {
+				return client.Contacts().Delete(context.Background(), "c1")
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			var apiErr *APIError
+			if err := tc.run(); !errors.As(err, &apiErr) || apiErr.Code != "request_error" {
+				t.Fatalf("expected APIError, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package pandadoc
+
+// ListContactsOptions configures contact listing.
+type ListContactsOptions struct {
+	Email string
+}
+
+// ContactRequest creates or updates a contact.
+type ContactRequest struct {
+	Email         string `json:"email,omitempty"`
+	FirstName     string `json:"first_name,omitempty"`
+	LastName      string `json:"last_name,omitempty"`
+	Company       string `json:"company,omitempty"`
+	JobTitle      string `json:"job_title,omitempty"`
+	Phone         string `json:"phone,omitempty"`
+	StreetAddress string `json:"street_address,omitempty"`
+	City          string `json:"city,omitempty"`
+	State         string `json:"state,omitempty"`
+	PostalCode    string `json:"postal_code,omitempty"`
+	Country       string `json:"country,omitempty"`
+}
+
+// Contact represents a PandaDoc contact.
+type Contact struct {
+	ID            string `json:"id,omitempty"`
+	Email         string `json:"email,omitempty"`
+	FirstName     string `json:"first_name,omitempty"`
+	LastName      string `json:"last_name,omitempty"`
+	Company       string `json:"company,omitempty"`
+	JobTitle      string `json:"job_title,omitempty"`
+	Phone         string `json:"phone,omitempty"`
+	StreetAddress string `json:"street_address,omitempty"`
+	City          string `json:"city,omitempty"`
+	State         string `json:"state,omitempty"`
+	PostalCode    string `json:"postal_code,omitempty"`
+	Country       string `json:"country,omitempty"`
+}
+
+// ContactListResponse is returned by list contacts endpoint.
+type ContactListResponse struct {
+	Results []Contact `json:"results"`
+}
@@ -0,0 +1,176 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// contentLibraryService implements ContentLibraryService.
+type contentLibraryService struct {
+	client *Client
+}
+
+// List lists content library items.
+func (s *contentLibraryService) List(ctx context.Context, opts *ListContentLibraryItemsOptions) (*ContentLibraryItemListResponse, error) {
+	query := url.Values{}
+	if opts != nil {
+		setIfNotEmpty(query, "q", opts.Q)
+		setIfNotEmpty(query, "id", opts.ID)
+		setIfNotNil(query, "deleted", opts.Deleted)
+		setIfNotEmpty(query, "folder_uuid", opts.FolderUUID)
+		setIfPositive(query, "count", opts.Count)
+		setIfPositive(query, "page", opts.Page)
+		for _, v := range opts.Tags {
+			query.Add("tag", v)
+		}
+	}
+
+	var out ContentLibraryItemListResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/content-library-items",
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Create creates a content library item from a file URL.
+func (s *contentLibraryService) Create(ctx context.Context, reqBody *CreateContentLibraryItemRequest) (*ContentLibraryItemStatusResponse, error) {
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out ContentLibraryItemStatusResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPost,
+		path:           "/public/v1/content-library-items",
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateFromUpload creates a content library item from an uploaded file.
+func (s *contentLibraryService) CreateFromUpload(ctx context.Context, reqBody *CreateContentLibraryItemFromUploadRequest) (*ContentLibraryItemStatusResponse, error) {
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+	if reqBody.File == nil {
+		return nil, ErrNilFileReader
+	}
+
+	fieldName := reqBody.FileField
+	if fieldName == "" {
+		fieldName = "file"
+	}
+
+	fields := make(map[string]string, len(reqBody.Fields)+1)
+	for k, v := range reqBody.Fields {
+		fields[k] = v
+	}
+	if reqBody.Name != "" {
+		data, err := json.Marshal(contentLibraryItemUploadData{Name: reqBody.Name})
+		if err != nil {
+			return nil, fmt.Errorf("encode content library item data: %w", err)
+		}
+		fields["data"] = string(data)
+	}
+
+	var out ContentLibraryItemStatusResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/content-library-items?upload",
+		requireAuth: true,
+		multipart: &multipartPayload{
+			Fields: fields,
+			Files: []multipartFile{{
+				FieldName:   fieldName,
+				FileName:    reqBody.FileName,
+				ContentType: reqBody.ContentType,
+				Reader:      reqBody.File,
+			}},
+		},
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Status returns the processing status of a content library item.
+func (s *contentLibraryService) Status(ctx context.Context, id string) (*ContentLibraryItemStatusResponse, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var out ContentLibraryItemStatusResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/content-library-items/" + escapedID,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Details returns content library item details.
+func (s *contentLibraryService) Details(ctx context.Context, id string) (*ContentLibraryItemDetailsResponse, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var out ContentLibraryItemDetailsResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/content-library-items/" + escapedID + "/details",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// WaitForProcessing polls a content library item until it leaves the uploaded state.
+//
+// Polls follow the client's RetryPolicy backoff unless opts overrides it and stop
+// when ctx is done. An item that ends in the error state returns ErrContentLibraryItemFailed.
+func (s *contentLibraryService) WaitForProcessing(ctx context.Context, id string, opts *PollOptions) (*ContentLibraryItemStatusResponse, error) {
+	var last *ContentLibraryItemStatusResponse
+	err := s.client.poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		status, err := s.Status(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		last = status
+		switch status.Status {
+		case ContentLibraryItemStatusProcessed:
+			return true, nil
+		case ContentLibraryItemStatusError:
+			return false, fmt.Errorf("%w: %s", ErrContentLibraryItemFailed, id)
+		case ContentLibraryItemStatusUploaded:
+			// still processing
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return last, nil
+}
@@ -0,0 +1,152 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+//nolint:gocognit // Test function that validates all content library methods
+func TestContentLibraryService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	var polls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/content-library-items":
+			q := r.URL.Query()
+			assertQueryEq(t, q, "q", "terms")
+			assertQueryEq(t, q, "deleted", "false")
+			assertQueryEq(t, q, "count", "10")
+			if got := q["tag"]; len(got) != 2 {
+				t.Fatalf("unexpected tags: %v", got)
+			}
+			_, _ = io.WriteString(w, `{"results":[{"id":"cli1","name":"Terms","version":"2"}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/content-library-items" && r.URL.Query().Has("upload"):
+			if err := r.ParseMultipartForm(1 << 20); err != nil {
+				t.Fatalf("parse multipart: %v", err)
+			}
+			if r.FormValue("data") != `{"name":"Uploaded"}` {
+				t.Fatalf("unexpected data field: %q", r.FormValue("data"))
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id":"cli3","status":"cli.UPLOADED"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/content-library-items":
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if payload["url"] != "https://example.com/terms.pdf" {
+				t.Fatalf("unexpected create payload: %+v", payload)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id":"cli2","name":"Terms","status":"cli.UPLOADED"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/content-library-items/cli2":
+			if polls.Add(1) < 2 {
+				_, _ = io.WriteString(w, `{"id":"cli2","status":"cli.UPLOADED"}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"id":"cli2","status":"cli.PROCESSED"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/content-library-items/cli2/details":
+			_, _ = io.WriteString(w, `{"id":"cli2","name":"Terms","created_by":{"id":"u1"},"tables":[{"name":"Pricing"}],"pricing":{"total":"10.00"}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/append-content-library-item":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"block_mapping":{"tables":[{"original_name":"b1","new_name":"b2"}]}}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.String())
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.ContentLibrary()
+
+	list, err := svc.List(ctx, &ListContentLibraryItemsOptions{Q: "terms", Deleted: ptrBool(false), Count: 10, Tags: []string{"a", "b"}})
+	if err != nil || len(list.Results) != 1 || list.Results[0].Version != "2" {
+		t.Fatalf("List failed: %v %+v", err, list)
+	}
+
+	created, err := svc.Create(ctx, &CreateContentLibraryItemRequest{URL: "https://example.com/terms.pdf", Name: "Terms"})
+	if err != nil || created.Status != ContentLibraryItemStatusUploaded {
+		t.Fatalf("Create failed: %v %+v", err, created)
+	}
+
+	uploaded, err := svc.CreateFromUpload(ctx, &CreateContentLibraryItemFromUploadRequest{FileName: "terms.pdf", File: strings.NewReader("PDF"), Name: "Uploaded"})
+	if err != nil || uploaded.ID != "cli3" {
+		t.Fatalf("CreateFromUpload failed: %v %+v", err, uploaded)
+	}
+
+	ready, err := svc.WaitForProcessing(ctx, created.ID, nil)
+	if err != nil || ready.Status != ContentLibraryItemStatusProcessed || polls.Load() != 2 {
+		t.Fatalf("WaitForProcessing failed: %v %+v", err, ready)
+	}
+
+	details, err := svc.Details(ctx, ready.ID)
+	if err != nil || details.CreatedBy == nil || len(details.Tables) != 1 || details.Pricing == nil || details.Pricing.Total != "10.00" {
+		t.Fatalf("Details failed: %v %+v", err, details)
+	}
+
+	appended, err := client.Documents().AppendContentLibraryItem(ctx, "doc1", AppendContentLibraryItemRequest{"id": ready.ID})
+	if err != nil || appended.BlockMapping.Rename(ContentBlockTables, "b1") != "b2" {
+		t.Fatalf("AppendContentLibraryItem failed: %v", err)
+	}
+}
+
+func TestContentLibraryService_WaitForProcessing(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/public/v1/content-library-items/broken":
+			_, _ = io.WriteString(w, `{"id":"broken","status":"cli.ERROR"}`)
+		case "/public/v1/content-library-items/slow":
+			_, _ = io.WriteString(w, `{"id":"slow","status":"cli.UPLOADED"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	if _, err := client.ContentLibrary().WaitForProcessing(ctx, "broken", nil); !errors.Is(err, ErrContentLibraryItemFailed) {
+		t.Fatalf("expected processing failed error, got %v", err)
+	}
+	if _, err := client.ContentLibrary().WaitForProcessing(ctx, "slow", &PollOptions{MaxAttempts: 3}); !errors.Is(err, ErrPollAttemptsExhausted) {
+		t.Fatalf("expected attempts exhausted error, got %v", err)
+	}
+}
+
+func TestContentLibraryService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	svc := client.ContentLibrary()
+
+	if _, err := svc.Create(ctx, nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if _, err := svc.CreateFromUpload(ctx, nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if _, err := svc.CreateFromUpload(ctx, &CreateContentLibraryItemFromUploadRequest{}); !errors.Is(err, ErrNilFileReader) {
+		t.Fatalf("expected nil file error, got %v", err)
+	}
+	if _, err := svc.Status(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+	if _, err := svc.Details(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+	if _, err := svc.WaitForProcessing(ctx, "", nil); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+}
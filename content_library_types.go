@@ -0,0 +1,94 @@
+package pandadoc
+
+import "io"
+
+// ContentLibraryItemStatus is the processing state of a content library item.
+type ContentLibraryItemStatus string
+
+// Supported content library item statuses.
+const (
+	// ContentLibraryItemStatusUploaded represents an item that is still being processed.
+	ContentLibraryItemStatusUploaded ContentLibraryItemStatus = "cli.UPLOADED"
+	// ContentLibraryItemStatusProcessed represents an item that is ready for use.
+	ContentLibraryItemStatusProcessed ContentLibraryItemStatus = "cli.PROCESSED"
+	// ContentLibraryItemStatusError represents an item that failed processing.
+	ContentLibraryItemStatusError ContentLibraryItemStatus = "cli.ERROR"
+)
+
+// ListContentLibraryItemsOptions controls list/search behavior for content library items.
+type ListContentLibraryItemsOptions struct {
+	Q          string
+	ID         string
+	Deleted    *bool
+	FolderUUID string
+	Count      int
+	Page       int
+	Tags       []string
+}
+
+// ContentLibraryItemSummary is a compact content library item record.
+type ContentLibraryItemSummary struct {
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Version      string `json:"version,omitempty"`
+	DateCreated  string `json:"date_created,omitempty"`
+	DateModified string `json:"date_modified,omitempty"`
+}
+
+// ContentLibraryItemListResponse is returned by list content library items endpoint.
+type ContentLibraryItemListResponse struct {
+	Results []ContentLibraryItemSummary `json:"results"`
+}
+
+// CreateContentLibraryItemRequest creates a content library item from a file URL.
+type CreateContentLibraryItemRequest struct {
+	URL  string `json:"url,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+// CreateContentLibraryItemFromUploadRequest uploads a file and creates a content library item.
+//
+// ContentType overrides the Content-Type inferred from FileName for the file part.
+type CreateContentLibraryItemFromUploadRequest struct {
+	FileField   string
+	FileName    string
+	ContentType string
+	File        io.Reader
+	Name        string
+	Fields      map[string]string
+}
+
+// contentLibraryItemUploadData is encoded into the multipart "data" field.
+type contentLibraryItemUploadData struct {
+	Name string `json:"name,omitempty"`
+}
+
+// ContentLibraryItemStatusResponse is returned by create and status endpoints.
+type ContentLibraryItemStatusResponse struct {
+	ID           string                   `json:"id,omitempty"`
+	Name         string                   `json:"name,omitempty"`
+	Version      string                   `json:"version,omitempty"`
+	Status       ContentLibraryItemStatus `json:"status,omitempty"`
+	DateCreated  string                   `json:"date_created,omitempty"`
+	DateModified string                   `json:"date_modified,omitempty"`
+}
+
+// ContentLibraryItemDetailsResponse is returned by content library item details endpoint.
+type ContentLibraryItemDetailsResponse struct {
+	ID                  string                       `json:"id,omitempty"`
+	Name                string                       `json:"name,omitempty"`
+	Version             string                       `json:"version,omitempty"`
+	DateCreated         string                       `json:"date_created,omitempty"`
+	DateModified        string                       `json:"date_modified,omitempty"`
+	ContentDateModified string                       `json:"content_date_modified,omitempty"`
+	CreatedBy           *UserReference               `json:"created_by,omitempty"`
+	Metadata            map[string]any               `json:"metadata,omitempty"`
+	Tags                []string                     `json:"tags,omitempty"`
+	Roles               []TemplateRole               `json:"roles,omitempty"`
+	Tokens              []TemplateToken              `json:"tokens,omitempty"`
+	Fields              []DocumentField              `json:"fields,omitempty"`
+	Pricing             *TemplatePricing             `json:"pricing,omitempty"`
+	ContentPlaceholders []TemplateContentPlaceholder `json:"content_placeholders,omitempty"`
+	Images              []TemplateImage              `json:"images,omitempty"`
+	Tables              []NamedContentBlock          `json:"tables,omitempty"`
+}
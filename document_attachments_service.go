@@ -0,0 +1,142 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// documentAttachmentsService implements DocumentAttachmentsService.
+type documentAttachmentsService struct {
+	client *Client
+}
+
+// List lists attachments for a document.
+func (s *documentAttachmentsService) List(ctx context.Context, documentID string) ([]DocumentAttachment, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []DocumentAttachment
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/documents/" + escapedID + "/attachments",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Create attaches a file to a document from an uploaded reader or a remote URL.
+func (s *documentAttachmentsService) Create(ctx context.Context, documentID string, reqBody *CreateDocumentAttachmentRequest) (*DocumentAttachment, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	sourceURL := strings.TrimSpace(reqBody.SourceURL)
+	switch {
+	case reqBody.File != nil && sourceURL != "":
+		return nil, ErrMultipleAttachmentSources
+	case reqBody.File == nil && sourceURL == "":
+		return nil, ErrAttachmentSourceRequired
+	}
+
+	req := &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/documents/" + escapedID + "/attachments",
+		requireAuth: true,
+	}
+	if reqBody.File != nil {
+		fields := map[string]string{}
+		if reqBody.Name != "" {
+			fields["name"] = reqBody.Name
+		}
+		req.path += "?upload"
+		req.multipart = &multipartPayload{
+			Fields: fields,
+			Files: []multipartFile{{
+				FieldName:   "file",
+				FileName:    reqBody.FileName,
+				ContentType: reqBody.ContentType,
+				Reader:      reqBody.File,
+			}},
+		}
+	} else {
+		req.jsonBody = &createDocumentAttachmentJSON{Source: sourceURL, Name: reqBody.Name}
+	}
+
+	var out DocumentAttachment
+	if err := s.client.decodeJSON(ctx, req, &out); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Get returns attachment details.
+func (s *documentAttachmentsService) Get(ctx context.Context, documentID, attachmentID string) (*DocumentAttachment, error) {
+	path, err := attachmentPath(documentID, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out DocumentAttachment
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        path,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete deletes an attachment.
+func (s *documentAttachmentsService) Delete(ctx context.Context, documentID, attachmentID string) error {
+	path, err := attachmentPath(documentID, attachmentID)
+	if err != nil {
+		return err
+	}
+
+	return s.client.decodeJSON(ctx, &request{
+		method:         http.MethodDelete,
+		path:           path,
+		requireAuth:    true,
+		expectedStatus: []int{http.StatusNoContent},
+	}, nil)
+}
+
+// Download streams an attachment's file contents.
+func (s *documentAttachmentsService) Download(ctx context.Context, documentID, attachmentID string) (*DownloadResponse, error) {
+	path, err := attachmentPath(documentID, attachmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.download(ctx, &request{
+		method:      http.MethodGet,
+		path:        path + "/download",
+		requireAuth: true,
+		accept:      "application/*",
+	})
+}
+
+func attachmentPath(documentID, attachmentID string) (string, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return "", err
+	}
+	escapedAttachmentID, err := escapePathParam(attachmentID)
+	if err != nil {
+		return "", fmt.Errorf("attachment id: %w", err)
+	}
+	return "/public/v1/documents/" + escapedID + "/attachments/" + escapedAttachmentID, nil
+}
@@ -0,0 +1,143 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+//nolint:gocognit // Test function that validates all attachment methods
+func TestDocumentAttachmentsService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	const attachmentJSON = `{"uuid":"a1","name":"terms.pdf","date_created":"2026-01-02T03:04:05Z","created_by":{"id":"u1","email":"owner@example.com"}}`
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/doc1/attachments":
+			_, _ = io.WriteString(w, `[`+attachmentJSON+`]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/attachments" && r.URL.Query().Has("upload"):
+			mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if err != nil || mediaType != "multipart/form-data" {
+				t.Fatalf("expected multipart, got %s", r.Header.Get("Content-Type"))
+			}
+			mr := multipart.NewReader(r.Body, params["boundary"])
+			seen := map[string]string{}
+			for {
+				part, partErr := mr.NextPart()
+				if errors.Is(partErr, io.EOF) {
+					break
+				}
+				if partErr != nil {
+					t.Fatalf("read multipart: %v", partErr)
+				}
+				b, _ := io.ReadAll(part)
+				seen[part.FormName()] = string(b)
+				if part.FormName() == "file" && part.FileName() != "terms.pdf" {
+					t.Fatalf("unexpected file name %q", part.FileName())
+				}
+			}
+			if seen["file"] != "PDF" || seen["name"] != "Terms" {
+				t.Fatalf("unexpected multipart payload: %+v", seen)
+			}
+			_, _ = io.WriteString(w, attachmentJSON)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/attachments":
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if payload["source"] != "https://example.com/terms.pdf" || payload["name"] != "Terms" {
+				t.Fatalf("unexpected create payload: %+v", payload)
+			}
+			_, _ = io.WriteString(w, attachmentJSON)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/doc1/attachments/a1":
+			_, _ = io.WriteString(w, attachmentJSON)
+		case r.Method == http.MethodDelete && r.URL.Path == "/public/v1/documents/doc1/attachments/a1":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/doc1/attachments/a1/download":
+			w.Header().Set("Content-Type", "application/pdf")
+			w.Header().Set("Content-Disposition", `attachment; filename="terms.pdf"`)
+			_, _ = io.WriteString(w, "PDF")
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.String())
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.DocumentAttachments()
+
+	list, err := svc.List(ctx, "doc1")
+	if err != nil || len(list) != 1 || list[0].UUID != "a1" || list[0].CreatedBy == nil || list[0].CreatedBy.Email != "owner@example.com" {
+		t.Fatalf("List failed: %v %+v", err, list)
+	}
+
+	fromURL, err := svc.Create(ctx, "doc1", &CreateDocumentAttachmentRequest{Name: "Terms", SourceURL: " https://example.com/terms.pdf "})
+	if err != nil || fromURL.UUID != "a1" {
+		t.Fatalf("Create from URL failed: %v %+v", err, fromURL)
+	}
+
+	uploaded, err := svc.Create(ctx, "doc1", &CreateDocumentAttachmentRequest{Name: "Terms", File: strings.NewReader("PDF"), FileName: "terms.pdf"})
+	if err != nil || uploaded.Name != "terms.pdf" {
+		t.Fatalf("Create from upload failed: %v %+v", err, uploaded)
+	}
+
+	details, err := svc.Get(ctx, "doc1", "a1")
+	if err != nil || details.DateCreated == "" {
+		t.Fatalf("Get failed: %v %+v", err, details)
+	}
+
+	if err = svc.Delete(ctx, "doc1", "a1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+
+	dl, err := svc.Download(ctx, "doc1", "a1")
+	if err != nil {
+		t.Fatalf("Download failed: %v", err)
+	}
+	body, _ := io.ReadAll(dl.Body)
+	_ = dl.Close()
+	if string(body) != "PDF" || dl.ContentType != "application/pdf" || !strings.Contains(dl.ContentDisposition, "terms.pdf") {
+		t.Fatalf("unexpected download: %q %+v", body, dl)
+	}
+}
+
+func TestDocumentAttachmentsService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	svc := client.DocumentAttachments()
+
+	if _, err := svc.List(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+	if _, err := svc.Create(ctx, "doc1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if _, err := svc.Create(ctx, "doc1", &CreateDocumentAttachmentRequest{Name: "x"}); !errors.Is(err, ErrAttachmentSourceRequired) {
+		t.Fatalf("expected missing source error, got %v", err)
+	}
+	if _, err := svc.Create(ctx, "doc1", &CreateDocumentAttachmentRequest{SourceURL: "https://example.com/a", File: strings.NewReader("x")}); !errors.Is(err, ErrMultipleAttachmentSources) {
+		t.Fatalf("expected multiple source error, got %v", err)
+	}
+	if _, err := svc.Get(ctx, "doc1", ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty attachment id error, got %v", err)
+	}
+	if err := svc.Delete(ctx, "", "a1"); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty document id error, got %v", err)
+	}
+	if _, err := svc.Download(ctx, "doc1", " "); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty attachment id error, got %v", err)
+	}
+}
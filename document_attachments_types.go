@@ -0,0 +1,29 @@
+package pandadoc
+
+import "io"
+
+// DocumentAttachment represents a file attached to a document.
+type DocumentAttachment struct {
+	UUID        string         `json:"uuid,omitempty"`
+	Name        string         `json:"name,omitempty"`
+	DateCreated string         `json:"date_created,omitempty"`
+	CreatedBy   *UserReference `json:"created_by,omitempty"`
+}
+
+// CreateDocumentAttachmentRequest attaches a file to a document.
+//
+// Set exactly one of File (uploaded as multipart) or SourceURL (fetched by PandaDoc).
+// ContentType applies to File only and defaults to a type inferred from FileName.
+type CreateDocumentAttachmentRequest struct {
+	Name        string
+	SourceURL   string
+	File        io.Reader
+	FileName    string
+	ContentType string
+}
+
+// createDocumentAttachmentJSON is the wire payload for URL-sourced attachments.
+type createDocumentAttachmentJSON struct {
+	Source string `json:"source"`
+	Name   string `json:"name,omitempty"`
+}
@@ -0,0 +1,75 @@
+package pandadoc
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// documentAuditTrailService implements DocumentAuditTrailService.
+type documentAuditTrailService struct {
+	client *Client
+}
+
+// auditTrailPageSize is the largest page the audit trail endpoint serves.
+const auditTrailPageSize = 100
+
+// List returns one page of a document's audit trail.
+func (s *documentAuditTrailService) List(ctx context.Context, documentID string, opts *AuditTrailOptions) (*AuditTrailResponse, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	if opts != nil {
+		setIfPositive(query, "limit", opts.Limit)
+		setIfPositive(query, "offset", opts.Offset)
+	}
+
+	var out AuditTrailResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v2/documents/" + escapedID + "/audit-trail",
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// All iterates over every audit trail event, fetching pages as needed.
+//
+// opts.Limit sets the page size (default 100) and opts.Offset the starting event.
+// Iteration stops at the first error, which is yielded once.
+func (s *documentAuditTrailService) All(ctx context.Context, documentID string, opts *AuditTrailOptions) iter.Seq2[AuditTrailEvent, error] {
+	page := AuditTrailOptions{Limit: auditTrailPageSize}
+	if opts != nil {
+		page = *opts
+		if page.Limit <= 0 {
+			page.Limit = auditTrailPageSize
+		}
+	}
+
+	return func(yield func(AuditTrailEvent, error) bool) {
+		for {
+			resp, err := s.List(ctx, documentID, &page)
+			if err != nil {
+				yield(AuditTrailEvent{}, err)
+				return
+			}
+			for _, event := range resp.Results {
+				if !yield(event, nil) {
+					return
+				}
+			}
+			page.Offset += len(resp.Results)
+			if len(resp.Results) < page.Limit || (resp.Count > 0 && page.Offset >= resp.Count) {
+				return
+			}
+		}
+	}
+}
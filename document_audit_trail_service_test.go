@@ -0,0 +1,111 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+//nolint:gocognit // Test function that validates audit trail paging
+func TestDocumentAuditTrailService_List(t *testing.T) {
+	t.Parallel()
+
+	const total = 5
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/public/v2/documents/doc1/audit-trail" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		if limit == 0 {
+			limit = 20
+		}
+		events := make([]map[string]any, 0, limit)
+		for i := offset; i < total && i < offset+limit; i++ {
+			events = append(events, map[string]any{
+				"id":           "e" + strconv.Itoa(i),
+				"action":       8,
+				"date_created": "2025-05-22T09:03:19Z",
+				"ip_address":   "8.8.8.8",
+				"reason":       nil,
+				"user":         map[string]any{"id": "u1", "email": "viewer@example.com"},
+			})
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"count": total, "results": events})
+	})
+
+	ctx := context.Background()
+	page, err := client.DocumentAuditTrail().List(ctx, "doc1", &AuditTrailOptions{Limit: 2, Offset: 1})
+	if err != nil || page.Count != total || len(page.Results) != 2 || page.Results[0].ID != "e1" {
+		t.Fatalf("List failed: %v %+v", err, page)
+	}
+	event := page.Results[0]
+	if event.Action != AuditTrailActionViewed || event.Action.String() != "viewed" || event.Actor.Email != "viewer@example.com" ||
+		event.IPAddress != "8.8.8.8" || event.Reason != "" || !event.DateCreated.Equal(time.Date(2025, 5, 22, 9, 3, 19, 0, time.UTC)) {
+		t.Fatalf("unexpected event: %+v", event)
+	}
+	if AuditTrailAction(99).String() != "action 99" {
+		t.Fatalf("unexpected unknown action string")
+	}
+
+	var ids []string
+	for e, iterErr := range client.DocumentAuditTrail().All(ctx, "doc1", &AuditTrailOptions{Limit: 2}) {
+		if iterErr != nil {
+			t.Fatalf("All failed: %v", iterErr)
+		}
+		ids = append(ids, e.ID)
+	}
+	if strings.Join(ids, ",") != "e0,e1,e2,e3,e4" {
+		t.Fatalf("unexpected iterated ids: %v", ids)
+	}
+
+	count := 0
+	for range client.DocumentAuditTrail().All(ctx, "doc1", nil) {
+		count++
+		if count == 3 {
+			break
+		}
+	}
+	if count != 3 {
+		t.Fatalf("expected early break to stop iteration, got %d", count)
+	}
+
+	var gotErr error
+	for _, iterErr := range client.DocumentAuditTrail().All(ctx, "", nil) {
+		gotErr = iterErr
+	}
+	if !errors.Is(gotErr, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", gotErr)
+	}
+}
+
+func TestDocumentAuditTrailService_AllWithoutCount(t *testing.T) {
+	t.Parallel()
+
+	pages := [][]string{{"e0", "e1"}, {"e2"}}
+	requests := 0
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		events := make([]map[string]any, 0, 2)
+		for _, id := range pages[requests] {
+			events = append(events, map[string]any{"id": id, "action": 8})
+		}
+		requests++
+		_ = json.NewEncoder(w).Encode(map[string]any{"results": events})
+	})
+
+	var ids []string
+	for e, err := range client.DocumentAuditTrail().All(context.Background(), "doc1", &AuditTrailOptions{Limit: 2}) {
+		if err != nil {
+			t.Fatalf("All failed: %v", err)
+		}
+		ids = append(ids, e.ID)
+	}
+	if strings.Join(ids, ",") != "e0,e1,e2" || requests != 2 {
+		t.Fatalf("expected paging to continue without a count, got %v after %d requests", ids, requests)
+	}
+}
@@ -0,0 +1,259 @@
+package pandadoc
+
+import (
+	"strconv"
+	"time"
+)
+
+// AuditTrailAction identifies what happened in an audit trail event.
+type AuditTrailAction int
+
+// Known audit trail actions.
+const (
+	// AuditTrailActionNewDocument is logged for "new document".
+	AuditTrailActionNewDocument AuditTrailAction = 1
+	// AuditTrailActionNewRevision is logged for "new revision".
+	AuditTrailActionNewRevision AuditTrailAction = 2
+	// AuditTrailActionApprovalRequest is logged for "approval request".
+	AuditTrailActionApprovalRequest AuditTrailAction = 3
+	// AuditTrailActionApproved is logged for "approved".
+	AuditTrailActionApproved AuditTrailAction = 4
+	// AuditTrailActionRejected is logged for "rejected".
+	AuditTrailActionRejected AuditTrailAction = 5
+	// AuditTrailActionSent is logged for "sent".
+	AuditTrailActionSent AuditTrailAction = 6
+	// AuditTrailActionCompletedByAll is logged for "completed by all".
+	AuditTrailActionCompletedByAll AuditTrailAction = 7
+	// AuditTrailActionViewed is logged for "viewed".
+	AuditTrailActionViewed AuditTrailAction = 8
+	// AuditTrailActionCompleted is logged for "completed".
+	AuditTrailActionCompleted AuditTrailAction = 9
+	// AuditTrailActionWaitingPay is logged for "waiting pay".
+	AuditTrailActionWaitingPay AuditTrailAction = 10
+	// AuditTrailActionPaid is logged for "paid".
+	AuditTrailActionPaid AuditTrailAction = 11
+	// AuditTrailActionForwarded is logged for "forwarded".
+	AuditTrailActionForwarded AuditTrailAction = 12
+	// AuditTrailActionExpired is logged for "expired".
+	AuditTrailActionExpired AuditTrailAction = 13
+	// AuditTrailActionBankAccountSubmitted is logged for "bank account submitted".
+	AuditTrailActionBankAccountSubmitted AuditTrailAction = 14
+	// AuditTrailActionBankAccountVerified is logged for "bank account verified".
+	AuditTrailActionBankAccountVerified AuditTrailAction = 15
+	// AuditTrailActionPaymentSubmitted is logged for "payment submitted".
+	AuditTrailActionPaymentSubmitted AuditTrailAction = 16
+	// AuditTrailActionPaymentFailed is logged for "payment failed".
+	AuditTrailActionPaymentFailed AuditTrailAction = 17
+	// AuditTrailActionCompletedManually is logged for "completed manually".
+	AuditTrailActionCompletedManually AuditTrailAction = 18
+	// AuditTrailActionExpiredManually is logged for "expired manually".
+	AuditTrailActionExpiredManually AuditTrailAction = 19
+	// AuditTrailActionPaidManually is logged for "paid manually".
+	AuditTrailActionPaidManually AuditTrailAction = 20
+	// AuditTrailActionApprovalDeleted is logged for "approval deleted".
+	AuditTrailActionApprovalDeleted AuditTrailAction = 21
+	// AuditTrailActionApprovalForced is logged for "approval forced".
+	AuditTrailActionApprovalForced AuditTrailAction = 22
+	// AuditTrailActionReassigned is logged for "reassigned".
+	AuditTrailActionReassigned AuditTrailAction = 23
+	// AuditTrailActionRecipientEdited is logged for "recipient edited".
+	AuditTrailActionRecipientEdited AuditTrailAction = 24
+	// AuditTrailActionRecipientAdded is logged for "recipient added".
+	AuditTrailActionRecipientAdded AuditTrailAction = 25
+	// AuditTrailActionRecipientDeleted is logged for "recipient deleted".
+	AuditTrailActionRecipientDeleted AuditTrailAction = 26
+	// AuditTrailActionDeclinedManually is logged for "declined manually".
+	AuditTrailActionDeclinedManually AuditTrailAction = 27
+	// AuditTrailActionApprovalStepSkipped is logged for "approval step skipped".
+	AuditTrailActionApprovalStepSkipped AuditTrailAction = 28
+	// AuditTrailActionSuggestEdits is logged for "suggest edits".
+	AuditTrailActionSuggestEdits AuditTrailAction = 29
+	// AuditTrailActionDeclined is logged for "declined".
+	AuditTrailActionDeclined AuditTrailAction = 43
+	// AuditTrailActionPartlyPaid is logged for "partly paid".
+	AuditTrailActionPartlyPaid AuditTrailAction = 44
+	// AuditTrailActionPartlyPaidManually is logged for "partly paid manually".
+	AuditTrailActionPartlyPaidManually AuditTrailAction = 45
+	// AuditTrailActionRecipientVerificationWithKBAPassed is logged for "recipient verification with kba passed".
+	AuditTrailActionRecipientVerificationWithKBAPassed AuditTrailAction = 47
+	// AuditTrailActionRecipientVerificationWithIDPassed is logged for "recipient verification with id passed".
+	AuditTrailActionRecipientVerificationWithIDPassed AuditTrailAction = 48
+	// AuditTrailActionRecipientVerificationWithTextSMSPassed is logged for "recipient verification with text sms passed".
+	AuditTrailActionRecipientVerificationWithTextSMSPassed AuditTrailAction = 49
+	// AuditTrailActionRecipientVerificationWithPasscodePassed is logged for "recipient verification with passcode passed".
+	AuditTrailActionRecipientVerificationWithPasscodePassed AuditTrailAction = 50
+	// AuditTrailActionRecipientVerificationWithKBAFailed is logged for "recipient verification with kba failed".
+	AuditTrailActionRecipientVerificationWithKBAFailed AuditTrailAction = 51
+	// AuditTrailActionRecipientVerificationWithIDFailed is logged for "recipient verification with id failed".
+	AuditTrailActionRecipientVerificationWithIDFailed AuditTrailAction = 52
+	// AuditTrailActionRecipientVerificationWithTextSMSFailed is logged for "recipient verification with text sms failed".
+	AuditTrailActionRecipientVerificationWithTextSMSFailed AuditTrailAction = 53
+	// AuditTrailActionRecipientVerificationWithPasscodeFailed is logged for "recipient verification with passcode failed".
+	AuditTrailActionRecipientVerificationWithPasscodeFailed AuditTrailAction = 54
+	// AuditTrailActionRecipientSignedWithQES is logged for "recipient signed with QES".
+	AuditTrailActionRecipientSignedWithQES AuditTrailAction = 55
+	// AuditTrailActionQESCompleted is logged for "QES completed".
+	AuditTrailActionQESCompleted AuditTrailAction = 56
+	// AuditTrailActionRecipientQESAttemptStarted is logged for "recipient QES attempt started".
+	AuditTrailActionRecipientQESAttemptStarted AuditTrailAction = 57
+	// AuditTrailActionRecipientQESAttemptExpired is logged for "recipient QES attempt expired".
+	AuditTrailActionRecipientQESAttemptExpired AuditTrailAction = 58
+	// AuditTrailActionOptionalItemInQuoteSelected is logged for "Optional item in quote selected".
+	AuditTrailActionOptionalItemInQuoteSelected AuditTrailAction = 59
+	// AuditTrailActionOptionalItemInQuoteDeselected is logged for "Optional item in quote deselected".
+	AuditTrailActionOptionalItemInQuoteDeselected AuditTrailAction = 60
+	// AuditTrailActionItemQuantityInQuoteChanged is logged for "Item quantity in quote changed".
+	AuditTrailActionItemQuantityInQuoteChanged AuditTrailAction = 61
+	// AuditTrailActionItemQuantityInPricingTableChanged is logged for "Item quantity in pricing table changed".
+	AuditTrailActionItemQuantityInPricingTableChanged AuditTrailAction = 62
+	// AuditTrailActionOptionalItemInPricingTableSelected is logged for "Optional item in pricing table selected".
+	AuditTrailActionOptionalItemInPricingTableSelected AuditTrailAction = 63
+	// AuditTrailActionOptionalItemInPricingTableDeselected is logged for "Optional item in pricing table deselected".
+	AuditTrailActionOptionalItemInPricingTableDeselected AuditTrailAction = 64
+	// AuditTrailActionSectionItemInPricingTableSelectedOneOf is logged for "Section item in pricing table selected (one of)".
+	AuditTrailActionSectionItemInPricingTableSelectedOneOf AuditTrailAction = 65
+	// AuditTrailActionOptionalSectionInQuoteSelected is logged for "Optional section in quote selected".
+	AuditTrailActionOptionalSectionInQuoteSelected AuditTrailAction = 66
+	// AuditTrailActionOptionalSectionInQuoteDeselected is logged for "Optional section in quote deselected".
+	AuditTrailActionOptionalSectionInQuoteDeselected AuditTrailAction = 67
+	// AuditTrailActionSectionQuantityInQuoteChanged is logged for "Section quantity in quote changed".
+	AuditTrailActionSectionQuantityInQuoteChanged AuditTrailAction = 68
+)
+
+// String returns the PandaDoc description of the action.
+func (a AuditTrailAction) String() string {
+	switch a {
+	case AuditTrailActionNewDocument:
+		return "new document"
+	case AuditTrailActionNewRevision:
+		return "new revision"
+	case AuditTrailActionApprovalRequest:
+		return "approval request"
+	case AuditTrailActionApproved:
+		return "approved"
+	case AuditTrailActionRejected:
+		return "rejected"
+	case AuditTrailActionSent:
+		return "sent"
+	case AuditTrailActionCompletedByAll:
+		return "completed by all"
+	case AuditTrailActionViewed:
+		return "viewed"
+	case AuditTrailActionCompleted:
+		return "completed"
+	case AuditTrailActionWaitingPay:
+		return "waiting pay"
+	case AuditTrailActionPaid:
+		return "paid"
+	case AuditTrailActionForwarded:
+		return "forwarded"
+	case AuditTrailActionExpired:
+		return "expired"
+	case AuditTrailActionBankAccountSubmitted:
+		return "bank account submitted"
+	case AuditTrailActionBankAccountVerified:
+		return "bank account verified"
+	case AuditTrailActionPaymentSubmitted:
+		return "payment submitted"
+	case AuditTrailActionPaymentFailed:
+		return "payment failed"
+	case AuditTrailActionCompletedManually:
+		return "completed manually"
+	case AuditTrailActionExpiredManually:
+		return "expired manually"
+	case AuditTrailActionPaidManually:
+		return "paid manually"
+	case AuditTrailActionApprovalDeleted:
+		return "approval deleted"
+	case AuditTrailActionApprovalForced:
+		return "approval forced"
+	case AuditTrailActionReassigned:
+		return "reassigned"
+	case AuditTrailActionRecipientEdited:
+		return "recipient edited"
+	case AuditTrailActionRecipientAdded:
+		return "recipient added"
+	case AuditTrailActionRecipientDeleted:
+		return "recipient deleted"
+	case AuditTrailActionDeclinedManually:
+		return "declined manually"
+	case AuditTrailActionApprovalStepSkipped:
+		return "approval step skipped"
+	case AuditTrailActionSuggestEdits:
+		return "suggest edits"
+	case AuditTrailActionDeclined:
+		return "declined"
+	case AuditTrailActionPartlyPaid:
+		return "partly paid"
+	case AuditTrailActionPartlyPaidManually:
+		return "partly paid manually"
+	case AuditTrailActionRecipientVerificationWithKBAPassed:
+		return "recipient verification with kba passed"
+	case AuditTrailActionRecipientVerificationWithIDPassed:
+		return "recipient verification with id passed"
+	case AuditTrailActionRecipientVerificationWithTextSMSPassed:
+		return "recipient verification with text sms passed"
+	case AuditTrailActionRecipientVerificationWithPasscodePassed:
+		return "recipient verification with passcode passed"
+	case AuditTrailActionRecipientVerificationWithKBAFailed:
+		return "recipient verification with kba failed"
+	case AuditTrailActionRecipientVerificationWithIDFailed:
+		return "recipient verification with id failed"
+	case AuditTrailActionRecipientVerificationWithTextSMSFailed:
+		return "recipient verification with text sms failed"
+	case AuditTrailActionRecipientVerificationWithPasscodeFailed:
+		return "recipient verification with passcode failed"
+	case AuditTrailActionRecipientSignedWithQES:
+		return "recipient signed with QES"
+	case AuditTrailActionQESCompleted:
+		return "QES completed"
+	case AuditTrailActionRecipientQESAttemptStarted:
+		return "recipient QES attempt started"
+	case AuditTrailActionRecipientQESAttemptExpired:
+		return "recipient QES attempt expired"
+	case AuditTrailActionOptionalItemInQuoteSelected:
+		return "Optional item in quote selected"
+	case AuditTrailActionOptionalItemInQuoteDeselected:
+		return "Optional item in quote deselected"
+	case AuditTrailActionItemQuantityInQuoteChanged:
+		return "Item quantity in quote changed"
+	case AuditTrailActionItemQuantityInPricingTableChanged:
+		return "Item quantity in pricing table changed"
+	case AuditTrailActionOptionalItemInPricingTableSelected:
+		return "Optional item in pricing table selected"
+	case AuditTrailActionOptionalItemInPricingTableDeselected:
+		return "Optional item in pricing table deselected"
+	case AuditTrailActionSectionItemInPricingTableSelectedOneOf:
+		return "Section item in pricing table selected (one of)"
+	case AuditTrailActionOptionalSectionInQuoteSelected:
+		return "Optional section in quote selected"
+	case AuditTrailActionOptionalSectionInQuoteDeselected:
+		return "Optional section in quote deselected"
+	case AuditTrailActionSectionQuantityInQuoteChanged:
+		return "Section quantity in quote changed"
+	default:
+		return "action " + strconv.Itoa(int(a))
+	}
+}
+
+// AuditTrailEvent is a single entry in a document audit trail.
+type AuditTrailEvent struct {
+	ID          string           `json:"id"`
+	Action      AuditTrailAction `json:"action"`
+	Actor       UserReference    `json:"user"`
+	IPAddress   string           `json:"ip_address,omitempty"`
+	Reason      string           `json:"reason,omitempty"`
+	DateCreated time.Time        `json:"date_created"`
+}
+
+// AuditTrailOptions controls audit trail paging.
+type AuditTrailOptions struct {
+	Limit  int
+	Offset int
+}
+
+// AuditTrailResponse is one page of a document audit trail.
+type AuditTrailResponse struct {
+	Count   int               `json:"count"`
+	Results []AuditTrailEvent `json:"results"`
+}
@@ -0,0 +1,121 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// documentDocxExportService implements DocumentDocxExportService.
+type documentDocxExportService struct {
+	client *Client
+}
+
+// Create starts an asynchronous DOCX export of a document (beta).
+func (s *documentDocxExportService) Create(ctx context.Context, id string) (*DocxExportTask, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var out DocxExportTask
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/beta/documents/" + escapedID + "/docx-export-tasks",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Get returns the state of a DOCX export task (beta).
+func (s *documentDocxExportService) Get(ctx context.Context, id, taskID string) (*DocxExportTask, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+	escapedTaskID, err := escapePathParam(taskID)
+	if err != nil {
+		return nil, fmt.Errorf("task id: %w", err)
+	}
+
+	var out DocxExportTask
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/beta/documents/" + escapedID + "/docx-export-tasks/" + escapedTaskID,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// WaitForExport polls a DOCX export task until it is done.
+//
+// Polls follow the client's RetryPolicy backoff unless opts overrides it and stop
+// when ctx is done. A task that ends in the error state returns ErrDocxExportFailed.
+func (s *documentDocxExportService) WaitForExport(ctx context.Context, id, taskID string, opts *PollOptions) (*DocxExportTask, error) {
+	var last *DocxExportTask
+	err := s.client.poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		task, err := s.Get(ctx, id, taskID)
+		if err != nil {
+			return false, err
+		}
+		last = task
+		switch task.Status {
+		case DocxExportStatusDone:
+			return true, nil
+		case DocxExportStatusError:
+			return false, fmt.Errorf("%w: task %s", ErrDocxExportFailed, taskID)
+		case DocxExportStatusCreated, DocxExportStatusProcessing:
+			// still processing
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return last, nil
+}
+
+// DownloadItem downloads one file of a finished DOCX export.
+//
+// Item URLs are pre-signed, so no credentials are sent with the request.
+func (s *documentDocxExportService) DownloadItem(ctx context.Context, item DocxExportItem) (*DownloadResponse, error) {
+	if item.URL == "" {
+		return nil, fmt.Errorf("%w: export item has no URL", ErrDocxExportFailed)
+	}
+
+	return s.client.download(ctx, &request{
+		method:      http.MethodGet,
+		externalURL: item.URL,
+		accept:      docxContentType,
+	})
+}
+
+// Export exports a document as DOCX, waits for the export, and downloads the file.
+//
+// Documents with several sections export one file per section; those return
+// ErrDocxExportMultipleFiles, and callers should use WaitForExport and
+// DownloadItem instead. The caller must close the returned response.
+func (s *documentDocxExportService) Export(ctx context.Context, id string, opts *PollOptions) (*DownloadResponse, error) {
+	task, err := s.Create(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if task, err = s.WaitForExport(ctx, id, task.ID, opts); err != nil {
+		return nil, err
+	}
+
+	switch len(task.Items) {
+	case 0:
+		return nil, fmt.Errorf("%w: task %s returned no files", ErrDocxExportFailed, task.ID)
+	case 1:
+		return s.DownloadItem(ctx, task.Items[0])
+	default:
+		return nil, fmt.Errorf("%w: task %s returned %d files", ErrDocxExportMultipleFiles, task.ID, len(task.Items))
+	}
+}
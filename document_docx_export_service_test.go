@@ -0,0 +1,86 @@
+package pandadoc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDocumentDocxExportService_Export(t *testing.T) {
+	t.Parallel()
+
+	var polls int
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/public/beta/documents/doc1/docx-export-tasks":
+			_, _ = io.WriteString(w, `{"id":"task1","document_id":"doc1","status":"created"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/beta/documents/doc1/docx-export-tasks/task1":
+			polls++
+			if polls < 2 {
+				_, _ = io.WriteString(w, `{"id":"task1","document_id":"doc1","status":"processing"}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"id":"task1","document_id":"doc1","status":"done","docx_items":[{"section_title":null,"url":"http://`+r.Host+`/files/doc1.docx"}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/beta/documents/multi/docx-export-tasks":
+			_, _ = io.WriteString(w, `{"id":"task2","document_id":"multi","status":"done"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/beta/documents/multi/docx-export-tasks/task2":
+			_, _ = io.WriteString(w, `{"id":"task2","document_id":"multi","status":"done","docx_items":[{"section_title":"A","url":"http://x/a"},{"section_title":"B","url":"http://x/b"}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/beta/documents/broken/docx-export-tasks":
+			_, _ = io.WriteString(w, `{"id":"task3","document_id":"broken","status":"created"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/beta/documents/broken/docx-export-tasks/task3":
+			_, _ = io.WriteString(w, `{"id":"task3","document_id":"broken","status":"error"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/beta/documents/empty/docx-export-tasks":
+			_, _ = io.WriteString(w, `{"id":"task4","document_id":"empty","status":"done"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/beta/documents/empty/docx-export-tasks/task4":
+			_, _ = io.WriteString(w, `{"id":"task4","document_id":"empty","status":"done"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/files/doc1.docx":
+			if got := r.Header.Get("Authorization"); got != "" {
+				t.Fatalf("expected no credentials on pre-signed download, got %q", got)
+			}
+			w.Header().Set("Content-Type", docxContentType)
+			_, _ = io.WriteString(w, "DOCX")
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.DocumentDocxExport()
+	poll := &PollOptions{Interval: time.Millisecond}
+
+	resp, err := svc.Export(ctx, "doc1", poll)
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+	defer func() { _ = resp.Close() }()
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != "DOCX" || resp.ContentType != docxContentType || polls != 2 {
+		t.Fatalf("unexpected export: %q %q polls=%d", body, resp.ContentType, polls)
+	}
+
+	if _, err = svc.Export(ctx, "multi", poll); !errors.Is(err, ErrDocxExportMultipleFiles) {
+		t.Fatalf("expected multiple files error, got %v", err)
+	}
+	task, err := svc.WaitForExport(ctx, "multi", "task2", poll)
+	if err != nil || len(task.Items) != 2 || *task.Items[1].SectionTitle != "B" {
+		t.Fatalf("WaitForExport failed: %v %+v", err, task)
+	}
+	if _, err = svc.Export(ctx, "broken", poll); !errors.Is(err, ErrDocxExportFailed) {
+		t.Fatalf("expected export failed error, got %v", err)
+	}
+	if _, err = svc.Export(ctx, "empty", poll); !errors.Is(err, ErrDocxExportFailed) {
+		t.Fatalf("expected export failed error for empty task, got %v", err)
+	}
+	if _, err = svc.DownloadItem(ctx, DocxExportItem{}); !errors.Is(err, ErrDocxExportFailed) {
+		t.Fatalf("expected missing URL error, got %v", err)
+	}
+	if _, err = svc.Export(ctx, "", poll); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty id error, got %v", err)
+	}
+	if _, err = svc.Get(ctx, "doc1", ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty task id error, got %v", err)
+	}
+}
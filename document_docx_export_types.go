@@ -0,0 +1,33 @@
+package pandadoc
+
+// DocxExportStatus is the state of a DOCX export task.
+type DocxExportStatus string
+
+// Supported DOCX export task statuses.
+const (
+	// DocxExportStatusCreated represents a task that has not started.
+	DocxExportStatusCreated DocxExportStatus = "created"
+	// DocxExportStatusProcessing represents a task that is exporting the document.
+	DocxExportStatusProcessing DocxExportStatus = "processing"
+	// DocxExportStatusDone represents a task whose files are ready to download.
+	DocxExportStatusDone DocxExportStatus = "done"
+	// DocxExportStatusError represents a task that failed.
+	DocxExportStatusError DocxExportStatus = "error"
+)
+
+// docxContentType is the MIME type of Word documents.
+const docxContentType = "application/vnd.openxmlformats-officedocument.wordprocessingml.document"
+
+// DocxExportItem is one exported file; documents with several sections export one file per section.
+type DocxExportItem struct {
+	SectionTitle *string `json:"section_title,omitempty"`
+	URL          string  `json:"url,omitempty"`
+}
+
+// DocxExportTask models a DOCX export task.
+type DocxExportTask struct {
+	ID         string           `json:"id"`
+	DocumentID string           `json:"document_id"`
+	Status     DocxExportStatus `json:"status"`
+	Items      []DocxExportItem `json:"docx_items,omitempty"`
+}
@@ -0,0 +1,62 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// documentFieldsService implements DocumentFieldsService.
+type documentFieldsService struct {
+	client *Client
+}
+
+// List lists fields placed on a document.
+func (s *documentFieldsService) List(ctx context.Context, documentID string) (*DocumentFieldsResponse, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out DocumentFieldsResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/documents/" + escapedID + "/fields",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Create places new fields on a document.
+func (s *documentFieldsService) Create(ctx context.Context, documentID string, reqBody *CreateDocumentFieldsRequest) (*DocumentFieldsResponse, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+	if len(reqBody.Fields) == 0 {
+		return nil, ErrNoFields
+	}
+	for i, f := range reqBody.Fields {
+		if err = f.validate(); err != nil {
+			return nil, fmt.Errorf("field %d: %w", i, err)
+		}
+	}
+
+	var out DocumentFieldsResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/documents/" + escapedID + "/fields",
+		requireAuth: true,
+		jsonBody:    reqBody,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,171 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDocumentFieldsService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/doc1/fields":
+			_, _ = io.WriteString(w, `{"fields":[
+				{"uuid":"f1","type":"text","value":"Acme","layout":{"page":1,"position":{"anchor_point":"topleft","offset_x":10,"offset_y":20},"style":{"width":100,"height":20}}},
+				{"uuid":"f2","type":"checkbox","value":true},
+				{"uuid":"f3","type":"date","value":"2019-12-31T00:00:00.000Z"},
+				{"uuid":"f4","type":"collect_file","value":{"name":"id.pdf","url":"https://example.com/id.pdf"}},
+				{"uuid":"f5","type":"signature","value":{}}
+			]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/fields":
+			var payload CreateDocumentFieldsRequest
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if len(payload.Fields) != 2 || payload.Fields[0].Type != FieldTypeSignature || payload.Fields[0].AssignedTo != "r1" ||
+				payload.Fields[0].Layout.Position.AnchorPoint != FieldAnchorTopLeft || payload.Fields[1].Layout.Position.AnchorPoint != FieldAnchorBottomRight {
+				t.Fatalf("unexpected create payload: %+v", payload)
+			}
+			_, _ = io.WriteString(w, `{"fields":[{"uuid":"n1","type":"signature"},{"uuid":"n2","type":"date"}]}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	list, err := client.DocumentFields().List(ctx, "doc1")
+	if err != nil || len(list.Fields) != 5 {
+		t.Fatalf("List failed: %v %+v", err, list)
+	}
+	if list.Fields[0].Layout == nil || list.Fields[0].Layout.Style.Width != 100 {
+		t.Fatalf("unexpected layout: %+v", list.Fields[0].Layout)
+	}
+	if s, ok := list.Fields[0].StringValue(); !ok || s != "Acme" {
+		t.Fatalf("unexpected text value: %q %v", s, ok)
+	}
+	if b, ok := list.Fields[1].BoolValue(); !ok || !b {
+		t.Fatalf("unexpected checkbox value")
+	}
+	if d, ok := list.Fields[2].DateValue(); !ok || !d.Equal(time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected date value: %v %v", d, ok)
+	}
+	if f, ok := list.Fields[3].FileValue(); !ok || f.Name != "id.pdf" {
+		t.Fatalf("unexpected file value: %+v", f)
+	}
+	if list.Fields[4].IsFilled() || !list.Fields[0].IsFilled() {
+		t.Fatalf("unexpected filled state")
+	}
+
+	req, err := NewDocumentFieldsBuilder().
+		Signature(FieldPlacement{Page: 1, X: 72, Y: 640, Width: 200, Height: 40}, WithFieldAssignee("r1")).
+		Date(FieldPlacement{Page: 1, X: 10, Y: 10, Width: 100, Height: 20, Anchor: FieldAnchorBottomRight}, WithFieldID("signed_on")).
+		Build()
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	created, err := client.DocumentFields().Create(ctx, "doc1", req)
+	if err != nil || len(created.Fields) != 2 || created.Fields[1].Kind() != FieldTypeDate {
+		t.Fatalf("Create failed: %v %+v", err, created)
+	}
+}
+
+func TestDocumentField_ValueGetters(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		field  DocumentField
+		str    bool
+		boolOK bool
+		date   bool
+		file   bool
+		filled bool
+	}{
+		{name: "empty text", field: DocumentField{Type: "text", Value: ""}, str: true},
+		{name: "dropdown", field: DocumentField{Type: "dropdown", Value: "Option 1"}, str: true, filled: true},
+		{name: "string checkbox", field: DocumentField{Type: "checkbox", Value: "true"}, str: true, boolOK: true, filled: true},
+		{name: "date only", field: DocumentField{Type: "date", Value: "2024-02-03"}, str: true, date: true, filled: true},
+		{name: "nil value", field: DocumentField{Type: "initials"}},
+		{name: "file on wrong type", field: DocumentField{Type: "text", Value: map[string]any{"name": "x"}}, filled: true},
+		{name: "empty file", field: DocumentField{Type: "collect_file", Value: map[string]any{}}},
+		{name: "number", field: DocumentField{Type: "text", Value: 1.5}, filled: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if _, ok := tc.field.StringValue(); ok != tc.str {
+				t.Fatalf("StringValue ok = %v", ok)
+			}
+			if _, ok := tc.field.BoolValue(); ok != tc.boolOK {
+				t.Fatalf("BoolValue ok = %v", ok)
+			}
+			if _, ok := tc.field.DateValue(); ok != tc.date {
+				t.Fatalf("DateValue ok = %v", ok)
+			}
+			if _, ok := tc.field.FileValue(); ok != tc.file {
+				t.Fatalf("FileValue ok = %v", ok)
+			}
+			if tc.field.IsFilled() != tc.filled {
+				t.Fatalf("IsFilled = %v", tc.field.IsFilled())
+			}
+		})
+	}
+}
+
+func TestDocumentFieldsService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	svc := client.DocumentFields()
+	if _, err := svc.List(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+	if _, err := svc.Create(ctx, "doc1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if _, err := svc.Create(ctx, "doc1", &CreateDocumentFieldsRequest{}); !errors.Is(err, ErrNoFields) {
+		t.Fatalf("expected no fields error, got %v", err)
+	}
+	bad := &CreateDocumentFieldsRequest{Fields: []CreateDocumentField{{Type: FieldTypeText, Layout: FieldLayout{Page: 1}}}}
+	if _, err := svc.Create(ctx, "doc1", bad); !errors.Is(err, ErrInvalidFieldLayout) {
+		t.Fatalf("expected layout error, got %v", err)
+	}
+
+	if _, err := NewDocumentFieldsBuilder().Build(); !errors.Is(err, ErrNoFields) {
+		t.Fatalf("expected no fields error, got %v", err)
+	}
+	_, err := NewDocumentFieldsBuilder().
+		Text(FieldPlacement{Page: 1, Width: 10, Height: 10}, nil, WithFieldPlaceholder("Name"), WithFieldMergeField("Client.Name")).
+		Add("", FieldPlacement{Page: 1, Width: 10, Height: 10}).
+		Build()
+	if !errors.Is(err, ErrFieldTypeRequired) || err.Error() != "field 1: field type is required" {
+		t.Fatalf("expected field type error, got %v", err)
+	}
+	_, err = NewDocumentFieldsBuilder().Checkbox(FieldPlacement{Width: 10, Height: 10}).Build()
+	if !errors.Is(err, ErrInvalidFieldLayout) {
+		t.Fatalf("expected layout error, got %v", err)
+	}
+
+	b := NewDocumentFieldsBuilder().
+		Dropdown(FieldPlacement{Page: 1, Width: 1, Height: 1}).
+		RadioButtons(FieldPlacement{Page: 1, Width: 1, Height: 1}).
+		Initials(FieldPlacement{Page: 1, Width: 1, Height: 1}).
+		CollectFile(FieldPlacement{Page: 2, Width: 1, Height: 1})
+	req, err := b.Build()
+	if err != nil || len(req.Fields) != 4 || req.Fields[3].Type != FieldTypeCollectFile {
+		t.Fatalf("unexpected build: %v %+v", err, req)
+	}
+}
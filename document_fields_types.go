@@ -0,0 +1,309 @@
+package pandadoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// FieldType identifies the kind of a document field.
+type FieldType string
+
+// Supported document field types.
+const (
+	// FieldTypeText is a free-text field.
+	FieldTypeText FieldType = "text"
+	// FieldTypeSignature is a signature field.
+	FieldTypeSignature FieldType = "signature"
+	// FieldTypeDate is a date field.
+	FieldTypeDate FieldType = "date"
+	// FieldTypeCheckbox is a checkbox field.
+	FieldTypeCheckbox FieldType = "checkbox"
+	// FieldTypeDropdown is a dropdown field.
+	FieldTypeDropdown FieldType = "dropdown"
+	// FieldTypeRadioButtons is a radio button group.
+	FieldTypeRadioButtons FieldType = "radio_buttons"
+	// FieldTypeInitials is an initials field.
+	FieldTypeInitials FieldType = "initials"
+	// FieldTypeCollectFile asks the recipient to upload a file.
+	FieldTypeCollectFile FieldType = "collect_file"
+	// FieldTypePaymentDetails collects payment details.
+	FieldTypePaymentDetails FieldType = "payment_details"
+	// FieldTypeStamp is a stamp field.
+	FieldTypeStamp FieldType = "stamp"
+)
+
+// FieldAnchorPoint is the corner of the page that field offsets are measured from.
+type FieldAnchorPoint string
+
+// Supported field anchor points.
+const (
+	// FieldAnchorTopLeft measures offsets from the top-left corner.
+	FieldAnchorTopLeft FieldAnchorPoint = "topleft"
+	// FieldAnchorTopRight measures offsets from the top-right corner.
+	FieldAnchorTopRight FieldAnchorPoint = "topright"
+	// FieldAnchorBottomLeft measures offsets from the bottom-left corner.
+	FieldAnchorBottomLeft FieldAnchorPoint = "bottomleft"
+	// FieldAnchorBottomRight measures offsets from the bottom-right corner.
+	FieldAnchorBottomRight FieldAnchorPoint = "bottomright"
+)
+
+// FieldLayout places a field on a document page.
+type FieldLayout struct {
+	Page     int           `json:"page"`
+	Position FieldPosition `json:"position"`
+	Style    FieldStyle    `json:"style"`
+}
+
+// FieldPosition is a field offset from an anchor point.
+type FieldPosition struct {
+	AnchorPoint FieldAnchorPoint `json:"anchor_point"`
+	OffsetX     float64          `json:"offset_x"`
+	OffsetY     float64          `json:"offset_y"`
+}
+
+// FieldStyle is the rendered size of a field.
+type FieldStyle struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+// CollectedFile is the value of a collect_file field once a recipient has uploaded a file.
+type CollectedFile struct {
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// DocumentFieldsResponse is returned by list/create document fields endpoints.
+type DocumentFieldsResponse struct {
+	Fields []DocumentField `json:"fields"`
+}
+
+// CreateDocumentField describes a new field to place on a document.
+type CreateDocumentField struct {
+	Type        FieldType   `json:"type"`
+	Layout      FieldLayout `json:"layout"`
+	AssignedTo  string      `json:"assigned_to,omitempty"`
+	FieldID     string      `json:"field_id,omitempty"`
+	MergeField  string      `json:"merge_field,omitempty"`
+	Placeholder string      `json:"placeholder,omitempty"`
+}
+
+// CreateDocumentFieldsRequest is the payload for creating document fields.
+type CreateDocumentFieldsRequest struct {
+	Fields []CreateDocumentField `json:"fields"`
+}
+
+// Kind returns the field's Type as a FieldType.
+func (f DocumentField) Kind() FieldType {
+	return FieldType(f.Type)
+}
+
+// StringValue returns the value of text, dropdown, radio button, and initials fields.
+func (f DocumentField) StringValue() (string, bool) {
+	v, ok := f.Value.(string)
+	return v, ok
+}
+
+// BoolValue returns the checked state of a checkbox field.
+func (f DocumentField) BoolValue() (bool, bool) {
+	switch v := f.Value.(type) {
+	case bool:
+		return v, true
+	case string:
+		b, err := strconv.ParseBool(v)
+		return b, err == nil
+	default:
+		return false, false
+	}
+}
+
+// DateValue parses the value of a date field.
+func (f DocumentField) DateValue() (time.Time, bool) {
+	v, ok := f.Value.(string)
+	if !ok || v == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range []string{time.RFC3339Nano, time.DateOnly} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// FileValue returns the uploaded file of a collect_file field.
+func (f DocumentField) FileValue() (*CollectedFile, bool) {
+	if f.Kind() != FieldTypeCollectFile || f.Value == nil {
+		return nil, false
+	}
+	raw, err := json.Marshal(f.Value)
+	if err != nil {
+		return nil, false
+	}
+	var out CollectedFile
+	if err = json.Unmarshal(raw, &out); err != nil || (out.Name == "" && out.URL == "") {
+		return nil, false
+	}
+	return &out, true
+}
+
+// IsFilled reports whether the field carries a non-empty value.
+//
+// Signature and initials fields report an empty object until they are signed.
+func (f DocumentField) IsFilled() bool {
+	switch v := f.Value.(type) {
+	case nil:
+		return false
+	case string:
+		return v != ""
+	case bool:
+		return v
+	case map[string]any:
+		return len(v) > 0
+	case []any:
+		return len(v) > 0
+	default:
+		return true
+	}
+}
+
+// FieldPlacement is a convenience description of where a new field goes.
+type FieldPlacement struct {
+	Page   int
+	X      float64
+	Y      float64
+	Width  int
+	Height int
+	Anchor FieldAnchorPoint
+}
+
+// FieldOption customizes a field added through DocumentFieldsBuilder.
+type FieldOption func(*CreateDocumentField)
+
+// WithFieldAssignee assigns the field to a recipient UUID.
+func WithFieldAssignee(recipientID string) FieldOption {
+	return func(f *CreateDocumentField) {
+		f.AssignedTo = recipientID
+	}
+}
+
+// WithFieldID sets an explicit field identifier.
+func WithFieldID(fieldID string) FieldOption {
+	return func(f *CreateDocumentField) {
+		f.FieldID = fieldID
+	}
+}
+
+// WithFieldMergeField binds the field to a merge field name.
+func WithFieldMergeField(name string) FieldOption {
+	return func(f *CreateDocumentField) {
+		f.MergeField = name
+	}
+}
+
+// WithFieldPlaceholder sets the placeholder text shown to recipients.
+func WithFieldPlaceholder(placeholder string) FieldOption {
+	return func(f *CreateDocumentField) {
+		f.Placeholder = placeholder
+	}
+}
+
+// DocumentFieldsBuilder accumulates fields for a CreateDocumentFieldsRequest.
+type DocumentFieldsBuilder struct {
+	fields []CreateDocumentField
+}
+
+// NewDocumentFieldsBuilder returns an empty fields builder.
+func NewDocumentFieldsBuilder() *DocumentFieldsBuilder {
+	return &DocumentFieldsBuilder{}
+}
+
+// Add places a field of any type.
+func (b *DocumentFieldsBuilder) Add(fieldType FieldType, at FieldPlacement, opts ...FieldOption) *DocumentFieldsBuilder {
+	anchor := at.Anchor
+	if anchor == "" {
+		anchor = FieldAnchorTopLeft
+	}
+	field := CreateDocumentField{
+		Type: fieldType,
+		Layout: FieldLayout{
+			Page:     at.Page,
+			Position: FieldPosition{AnchorPoint: anchor, OffsetX: at.X, OffsetY: at.Y},
+			Style:    FieldStyle{Width: at.Width, Height: at.Height},
+		},
+	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(&field)
+		}
+	}
+	b.fields = append(b.fields, field)
+	return b
+}
+
+// Text places a text field.
+func (b *DocumentFieldsBuilder) Text(at FieldPlacement, opts ...FieldOption) *DocumentFieldsBuilder {
+	return b.Add(FieldTypeText, at, opts...)
+}
+
+// Signature places a signature field.
+func (b *DocumentFieldsBuilder) Signature(at FieldPlacement, opts ...FieldOption) *DocumentFieldsBuilder {
+	return b.Add(FieldTypeSignature, at, opts...)
+}
+
+// Date places a date field.
+func (b *DocumentFieldsBuilder) Date(at FieldPlacement, opts ...FieldOption) *DocumentFieldsBuilder {
+	return b.Add(FieldTypeDate, at, opts...)
+}
+
+// Checkbox places a checkbox field.
+func (b *DocumentFieldsBuilder) Checkbox(at FieldPlacement, opts ...FieldOption) *DocumentFieldsBuilder {
+	return b.Add(FieldTypeCheckbox, at, opts...)
+}
+
+// Dropdown places a dropdown field.
+func (b *DocumentFieldsBuilder) Dropdown(at FieldPlacement, opts ...FieldOption) *DocumentFieldsBuilder {
+	return b.Add(FieldTypeDropdown, at, opts...)
+}
+
+// RadioButtons places a radio button group.
+func (b *DocumentFieldsBuilder) RadioButtons(at FieldPlacement, opts ...FieldOption) *DocumentFieldsBuilder {
+	return b.Add(FieldTypeRadioButtons, at, opts...)
+}
+
+// Initials places an initials field.
+func (b *DocumentFieldsBuilder) Initials(at FieldPlacement, opts ...FieldOption) *DocumentFieldsBuilder {
+	return b.Add(FieldTypeInitials, at, opts...)
+}
+
+// CollectFile places a file upload field.
+func (b *DocumentFieldsBuilder) CollectFile(at FieldPlacement, opts ...FieldOption) *DocumentFieldsBuilder {
+	return b.Add(FieldTypeCollectFile, at, opts...)
+}
+
+// Build validates the accumulated fields and returns the request payload.
+func (b *DocumentFieldsBuilder) Build() (*CreateDocumentFieldsRequest, error) {
+	if len(b.fields) == 0 {
+		return nil, ErrNoFields
+	}
+	for i, f := range b.fields {
+		if err := f.validate(); err != nil {
+			return nil, fmt.Errorf("field %d: %w", i, err)
+		}
+	}
+	fields := make([]CreateDocumentField, len(b.fields))
+	copy(fields, b.fields)
+	return &CreateDocumentFieldsRequest{Fields: fields}, nil
+}
+
+func (f CreateDocumentField) validate() error {
+	if f.Type == "" {
+		return ErrFieldTypeRequired
+	}
+	if f.Layout.Page < 1 || f.Layout.Style.Width <= 0 || f.Layout.Style.Height <= 0 {
+		return ErrInvalidFieldLayout
+	}
+	return nil
+}
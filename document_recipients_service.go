@@ -0,0 +1,159 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// documentRecipientsService implements DocumentRecipientsService.
+type documentRecipientsService struct {
+	client *Client
+}
+
+// Add adds a CC recipient to a document.
+func (s *documentRecipientsService) Add(ctx context.Context, documentID string, reqBody *AddDocumentRecipientRequest) (*DocumentRecipient, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	target, err := s.resolveTarget(ctx, reqBody.ContactID, reqBody.Email, reqBody.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var out documentRecipientResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/documents/" + escapedID + "/recipients",
+		requireAuth: true,
+		jsonBody:    target,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	return recipientFromTarget(out.RecipientID, target, reqBody.Email), nil
+}
+
+// Edit edits a recipient's details in a document.
+func (s *documentRecipientsService) Edit(ctx context.Context, documentID, recipientID string, reqBody *EditDocumentRecipientRequest) error {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return err
+	}
+	escapedRecipientID, err := escapePathParam(recipientID)
+	if err != nil {
+		return fmt.Errorf("recipient id: %w", err)
+	}
+	if reqBody == nil {
+		return ErrNilRequest
+	}
+
+	return s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPatch,
+		path:           "/public/v1/documents/" + escapedID + "/recipients/recipient/" + escapedRecipientID,
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusNoContent},
+	}, nil)
+}
+
+// Delete removes a recipient from a document.
+func (s *documentRecipientsService) Delete(ctx context.Context, documentID, recipientID string) error {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return err
+	}
+	escapedRecipientID, err := escapePathParam(recipientID)
+	if err != nil {
+		return fmt.Errorf("recipient id: %w", err)
+	}
+
+	return s.client.decodeJSON(ctx, &request{
+		method:         http.MethodDelete,
+		path:           "/public/v1/documents/" + escapedID + "/recipients/" + escapedRecipientID,
+		requireAuth:    true,
+		expectedStatus: []int{http.StatusNoContent},
+	}, nil)
+}
+
+// Reassign replaces a signer with another contact.
+//
+// The endpoint takes only the replacement contact; it does not accept a reason
+// for the reassignment.
+func (s *documentRecipientsService) Reassign(ctx context.Context, documentID, recipientID string, reqBody *ReassignDocumentRecipientRequest) (*DocumentRecipient, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	escapedRecipientID, err := escapePathParam(recipientID)
+	if err != nil {
+		return nil, fmt.Errorf("recipient id: %w", err)
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	target, err := s.resolveTarget(ctx, reqBody.ContactID, reqBody.Email, reqBody.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var out documentRecipientResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/documents/" + escapedID + "/recipients/" + escapedRecipientID + "/reassign",
+		requireAuth: true,
+		jsonBody:    target,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+
+	return recipientFromTarget(out.RecipientID, target, reqBody.Email), nil
+}
+
+// resolveTarget builds the add/reassign payload, looking up the contact by
+// email when no contact ID is given. Contact groups must be given by ID.
+func (s *documentRecipientsService) resolveTarget(ctx context.Context, contactID, email string, kind RecipientKind) (*documentRecipientTarget, error) {
+	if kind == "" {
+		kind = RecipientKindContact
+	}
+	contactID = strings.TrimSpace(contactID)
+	if contactID != "" {
+		return &documentRecipientTarget{ID: contactID, Kind: kind}, nil
+	}
+
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return nil, ErrRecipientTargetRequired
+	}
+	if kind == RecipientKindContactGroup {
+		return nil, ErrContactGroupIDRequired
+	}
+
+	contacts, err := s.client.Contacts().List(ctx, &ListContactsOptions{Email: email})
+	if err != nil {
+		return nil, fmt.Errorf("look up contact %q: %w", email, err)
+	}
+	for _, c := range contacts.Results {
+		if strings.EqualFold(c.Email, email) {
+			return &documentRecipientTarget{ID: c.ID, Kind: kind}, nil
+		}
+	}
+	return nil, fmt.Errorf("%s: %w", email, ErrContactNotFound)
+}
+
+func recipientFromTarget(recipientID string, target *documentRecipientTarget, email string) *DocumentRecipient {
+	out := &DocumentRecipient{ID: recipientID, Email: strings.TrimSpace(email)}
+	if target.Kind == RecipientKindContact {
+		out.ContactID = target.ID
+	}
+	return out
+}
@@ -0,0 +1,199 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+//nolint:gocognit // Test function that validates all recipient methods
+func TestDocumentRecipientsService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/contacts":
+			assertQueryEq(t, r.URL.Query(), "email", "new@example.com")
+			_, _ = io.WriteString(w, `{"results":[{"id":"other","email":"new@example.org"},{"id":"c2","email":"NEW@example.com"}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/recipients":
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if payload["id"] != "c1" || payload["kind"] != "contact" {
+				t.Fatalf("unexpected add payload: %+v", payload)
+			}
+			_, _ = io.WriteString(w, `{"recipient_id":"r1"}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/public/v1/documents/doc1/recipients/recipient/r1":
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if payload["email"] != "fixed@example.com" {
+				t.Fatalf("unexpected edit payload: %+v", payload)
+			}
+			if dm, ok := payload["delivery_methods"].(map[string]any); !ok || dm["sms"] != true {
+				t.Fatalf("unexpected delivery methods: %+v", payload)
+			}
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && r.URL.Path == "/public/v1/documents/doc1/recipients/r1":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/recipients/r1/reassign":
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if payload["id"] != "c2" || payload["kind"] != "contact" || payload["reason"] != nil {
+				t.Fatalf("unexpected reassign payload: %+v", payload)
+			}
+			_, _ = io.WriteString(w, `{"recipient_id":"r2"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	added, err := client.DocumentRecipients().Add(context.Background(), "doc1", &AddDocumentRecipientRequest{ContactID: "c1"})
+	if err != nil || added.ID != "r1" || added.ContactID != "c1" {
+		t.Fatalf("Add failed: %v %+v", err, added)
+	}
+
+	err = client.DocumentRecipients().Edit(context.Background(), "doc1", "r1", &EditDocumentRecipientRequest{
+		Email:           "fixed@example.com",
+		DeliveryMethods: &RecipientDeliveryMethods{Email: true, SMS: true},
+	})
+	if err != nil {
+		t.Fatalf("Edit failed: %v", err)
+	}
+
+	reassigned, err := client.DocumentRecipients().Reassign(context.Background(), "doc1", "r1", &ReassignDocumentRecipientRequest{
+		Email: "new@example.com",
+	})
+	if err != nil || reassigned.ID != "r2" || reassigned.ContactID != "c2" || reassigned.Email != "new@example.com" {
+		t.Fatalf("Reassign failed: %v %+v", err, reassigned)
+	}
+
+	if err := client.DocumentRecipients().Delete(context.Background(), "doc1", "r1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+}
+
+func TestDocumentRecipientsService_ContactGroupAndLookupMiss(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/contacts":
+			_, _ = io.WriteString(w, `{"results":[]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/recipients":
+			var payload map[string]any
+			_ = json.NewDecoder(r.Body).Decode(&payload)
+			if payload["kind"] != "contact_group" {
+				t.Fatalf("unexpected add payload: %+v", payload)
+			}
+			_, _ = io.WriteString(w, `{"recipient_id":"r9"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	added, err := client.DocumentRecipients().Add(context.Background(), "doc1", &AddDocumentRecipientRequest{ContactID: "g1", Kind: RecipientKindContactGroup})
+	if err != nil || added.ID != "r9" || added.ContactID != "" {
+		t.Fatalf("Add group failed: %v %+v", err, added)
+	}
+
+	if _, err := client.DocumentRecipients().Add(context.Background(), "doc1", &AddDocumentRecipientRequest{Email: "missing@example.com"}); !errors.Is(err, ErrContactNotFound) {
+		t.Fatalf("expected ErrContactNotFound, got %v", err)
+	}
+}
+
+func TestDocumentRecipientsService_ErrorClassification(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPatch:
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, `{"type":"request_error","detail":{"email":["Enter a valid email address."]}}`)
+		case http.MethodDelete:
+			w.WriteHeader(http.StatusConflict)
+			_, _ = io.WriteString(w, `{"type":"request_error","detail":"Signer can be removed only from a document in draft status"}`)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+
+	err := client.DocumentRecipients().Edit(context.Background(), "doc1", "r1", &EditDocumentRecipientRequest{Email: "bad"})
+	if !IsValidationError(err) || IsInvalidDocumentState(err) {
+		t.Fatalf("expected validation error, got %v", err)
+	}
+
+	err = client.DocumentRecipients().Delete(context.Background(), "doc1", "r1")
+	if !IsInvalidDocumentState(err) || IsValidationError(err) {
+		t.Fatalf("expected document state error, got %v", err)
+	}
+
+	if _, err := client.DocumentRecipients().Add(context.Background(), "doc1", &AddDocumentRecipientRequest{Email: "x@example.com"}); err == nil {
+		t.Fatalf("expected contact lookup error")
+	}
+	if _, err := client.DocumentRecipients().Reassign(context.Background(), "doc1", "r1", &ReassignDocumentRecipientRequest{ContactID: "c1"}); err == nil {
+		t.Fatalf("expected reassign error")
+	}
+	if _, err := client.DocumentRecipients().Add(context.Background(), "doc1", &AddDocumentRecipientRequest{ContactID: "c1"}); err == nil {
+		t.Fatalf("expected add error")
+	}
+}
+
+func TestDocumentRecipientsService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+	ctx := context.Background()
+	svc := client.DocumentRecipients()
+
+	if _, err := svc.Add(ctx, "", &AddDocumentRecipientRequest{ContactID: "c1"}); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected path param error, got %v", err)
+	}
+	if _, err := svc.Add(ctx, "doc1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request, got %v", err)
+	}
+	if _, err := svc.Add(ctx, "doc1", &AddDocumentRecipientRequest{}); !errors.Is(err, ErrRecipientTargetRequired) {
+		t.Fatalf("expected ErrRecipientTargetRequired, got %v", err)
+	}
+	if _, err := svc.Add(ctx, "doc1", &AddDocumentRecipientRequest{Email: "team@example.com", Kind: RecipientKindContactGroup}); !errors.Is(err, ErrContactGroupIDRequired) {
+		t.Fatalf("expected ErrContactGroupIDRequired, got %v", err)
+	}
+	if err := svc.Edit(ctx, "", "r1", &EditDocumentRecipientRequest{}); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected path param error, got %v", err)
+	}
+	if err := svc.Edit(ctx, "doc1", "", &EditDocumentRecipientRequest{}); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected recipient path param error, got %v", err)
+	}
+	if err := svc.Edit(ctx, "doc1", "r1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request, got %v", err)
+	}
+	if err := svc.Delete(ctx, "", "r1"); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected path param error, got %v", err)
+	}
+	if err := svc.Delete(ctx, "doc1", ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected recipient path param error, got %v", err)
+	}
+	if _, err := svc.Reassign(ctx, "", "r1", &ReassignDocumentRecipientRequest{ContactID: "c1"}); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected path param error, got %v", err)
+	}
+	if _, err := svc.Reassign(ctx, "doc1", "", &ReassignDocumentRecipientRequest{ContactID: "c1"}); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected recipient path param error, got %v", err)
+	}
+	if _, err := svc.Reassign(ctx, "doc1", "r1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request, got %v", err)
+	}
+	if _, err := svc.Reassign(ctx, "doc1", "r1", &ReassignDocumentRecipientRequest{Email: "  "}); !errors.Is(err, ErrRecipientTargetRequired) {
+		t.Fatalf("expected ErrRecipientTargetRequired, got %v", err)
+	}
+}
@@ -0,0 +1,116 @@
+package pandadoc
+
+// RecipientKind identifies whether a recipient target is a contact or a contact group.
+type RecipientKind string
+
+// Recipient kind constants.
+const (
+	// RecipientKindContact targets an individual contact.
+	RecipientKindContact RecipientKind = "contact"
+	// RecipientKindContactGroup targets a contact group.
+	RecipientKindContactGroup RecipientKind = "contact_group"
+)
+
+// AddDocumentRecipientRequest adds a CC recipient to a document.
+//
+// Set ContactID, or set Email to look up an existing contact by email.
+// Kind defaults to RecipientKindContact; contact groups are only accepted by
+// ID in ContactID.
+type AddDocumentRecipientRequest struct {
+	ContactID string
+	Email     string
+	Kind      RecipientKind
+}
+
+// ReassignDocumentRecipientRequest replaces a signer with another contact.
+//
+// Set ContactID, or set Email to look up an existing contact by email.
+// Kind defaults to RecipientKindContact; contact groups are only accepted by
+// ID in ContactID.
+type ReassignDocumentRecipientRequest struct {
+	ContactID string
+	Email     string
+	Kind      RecipientKind
+}
+
+// EditDocumentRecipientRequest edits a recipient's details in a document.
+type EditDocumentRecipientRequest struct {
+	Email                string                         `json:"email,omitempty"`
+	FirstName            string                         `json:"first_name,omitempty"`
+	LastName             string                         `json:"last_name,omitempty"`
+	Company              string                         `json:"company,omitempty"`
+	JobTitle             string                         `json:"job_title,omitempty"`
+	Phone                string                         `json:"phone,omitempty"`
+	StreetAddress        string                         `json:"street_address,omitempty"`
+	City                 string                         `json:"city,omitempty"`
+	State                string                         `json:"state,omitempty"`
+	PostalCode           string                         `json:"postal_code,omitempty"`
+	DeliveryMethods      *RecipientDeliveryMethods      `json:"delivery_methods,omitempty"`
+	Redirect             *RecipientRedirect             `json:"redirect,omitempty"`
+	VerificationSettings *RecipientVerificationSettings `json:"verification_settings,omitempty"`
+}
+
+// RecipientDeliveryMethods controls how a recipient receives the document.
+type RecipientDeliveryMethods struct {
+	Email bool `json:"email"`
+	SMS   bool `json:"sms"`
+}
+
+// RecipientRedirect controls where a recipient is sent after completing the document.
+type RecipientRedirect struct {
+	IsEnabled bool   `json:"is_enabled"`
+	URL       string `json:"url"`
+}
+
+// RecipientVerificationPlace controls when recipient verification happens.
+type RecipientVerificationPlace string
+
+// Recipient verification place constants.
+const (
+	// RecipientVerificationBeforeOpen verifies the recipient before opening the document.
+	RecipientVerificationBeforeOpen RecipientVerificationPlace = "before_open"
+	// RecipientVerificationBeforeSign verifies the recipient before signing the document.
+	RecipientVerificationBeforeSign RecipientVerificationPlace = "before_sign"
+)
+
+// RecipientVerificationSettings configures recipient identity verification.
+type RecipientVerificationSettings struct {
+	VerificationPlace    RecipientVerificationPlace     `json:"verification_place,omitempty"`
+	IDVerification       *RecipientIDVerification       `json:"id_verification,omitempty"`
+	KBAVerification      *RecipientKBAVerification      `json:"kba_verification,omitempty"`
+	PasscodeVerification *RecipientPasscodeVerification `json:"passcode_verification,omitempty"`
+	PhoneVerification    *RecipientPhoneVerification    `json:"phone_verification,omitempty"`
+}
+
+// RecipientIDVerification toggles ID document verification.
+type RecipientIDVerification struct {
+	Enabled bool `json:"enabled"`
+}
+
+// RecipientKBAVerification configures knowledge-based verification.
+type RecipientKBAVerification struct {
+	MaxAttemptsCount int `json:"max_attempts_count,omitempty"`
+	ScoreThreshold   int `json:"score_threshold,omitempty"`
+	TimeLimitSec     int `json:"time_limit_sec,omitempty"`
+}
+
+// RecipientPasscodeVerification configures passcode verification.
+type RecipientPasscodeVerification struct {
+	Passcode string `json:"passcode"`
+}
+
+// RecipientPhoneVerification configures SMS verification.
+type RecipientPhoneVerification struct {
+	PhoneNumber string `json:"phone_number"`
+}
+
+// documentRecipientTarget is the wire payload for add/reassign recipient calls.
+type documentRecipientTarget struct {
+	ID   string        `json:"id"`
+	Kind RecipientKind `json:"kind"`
+}
+
+// documentRecipientResponse is the wire response for add/reassign recipient calls.
+type documentRecipientResponse struct {
+	RecipientID string `json:"recipient_id"`
+}
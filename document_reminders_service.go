@@ -0,0 +1,102 @@
+package pandadoc
+
+import (
+	"context"
+	"net/http"
+)
+
+// documentRemindersService implements DocumentRemindersService.
+type documentRemindersService struct {
+	client *Client
+}
+
+// GetAutoReminders returns auto-reminder settings for a document.
+func (s *documentRemindersService) GetAutoReminders(ctx context.Context, documentID string) (*AutoReminderSettings, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out AutoReminderSettings
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/documents/" + escapedID + "/auto-reminders",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateAutoReminders updates auto-reminder settings for a document.
+func (s *documentRemindersService) UpdateAutoReminders(ctx context.Context, documentID string, settings *AutoReminderSettings) (*AutoReminderSettings, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return nil, ErrNilRequest
+	}
+	wire, err := settings.toWire()
+	if err != nil {
+		return nil, err
+	}
+
+	var out AutoReminderSettings
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPatch,
+		path:        "/public/v1/documents/" + escapedID + "/auto-reminders",
+		requireAuth: true,
+		jsonBody:    wire,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// AutoReminderStatus returns the auto-reminder state for each recipient.
+func (s *documentRemindersService) AutoReminderStatus(ctx context.Context, documentID string) (*AutoReminderStatusResponse, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out AutoReminderStatusResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/documents/" + escapedID + "/auto-reminders/status",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// SendReminder sends a manual reminder to one or more recipients.
+func (s *documentRemindersService) SendReminder(ctx context.Context, documentID string, reqBody *SendReminderRequest) (*SendReminderResponse, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+	if len(reqBody.Reminders) == 0 {
+		return nil, ErrNoReminders
+	}
+
+	var out SendReminderResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/documents/" + escapedID + "/send-reminder",
+		requireAuth: true,
+		jsonBody:    reqBody,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,133 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestDocumentRemindersService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/doc1/auto-reminders":
+			_, _ = io.WriteString(w, `{"enabled":true,"delivery_method":"email","initial_delay_days":2,"is_recurring":true,"recurrence_frequency_days":3}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/public/v1/documents/doc1/auto-reminders":
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if payload["enabled"] != true || payload["initial_delay_days"] != float64(1) || payload["recurrence_frequency_days"] != float64(7) ||
+				payload["delivery_method"] != "sms" || payload["is_recurring"] != true {
+				t.Fatalf("unexpected update payload: %+v", payload)
+			}
+			_, _ = io.WriteString(w, `{"enabled":true,"delivery_method":"sms","initial_delay_days":1,"is_recurring":true,"recurrence_frequency_days":7}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/doc1/auto-reminders/status":
+			_, _ = io.WriteString(w, `{"result":[{"recipient_id":"r1","status":"scheduled"}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/send-reminder":
+			var payload SendReminderRequest
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if len(payload.Reminders) != 1 || payload.Reminders[0].RecipientID != "r1" || payload.Reminders[0].EmailCustomization.Subject != "Reminder" {
+				t.Fatalf("unexpected reminder payload: %+v", payload)
+			}
+			_, _ = io.WriteString(w, `{"result":[{"recipient_id":"r1","email":{"status":"sent","sent_at":"2026-01-02T03:04:05Z"},"sms":{"status":"error","detail":"Can't send SMS reminder to the Recipient with no phone number"}}]}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.DocumentReminders()
+
+	settings, err := svc.GetAutoReminders(ctx, "doc1")
+	if err != nil || !settings.Enabled || settings.InitialDelay != 48*time.Hour || settings.RecurrenceFrequency != 72*time.Hour || settings.DeliveryMethod != ReminderDeliveryEmail {
+		t.Fatalf("GetAutoReminders failed: %v %+v", err, settings)
+	}
+
+	updated, err := svc.UpdateAutoReminders(ctx, "doc1", &AutoReminderSettings{
+		Enabled:             true,
+		DeliveryMethod:      ReminderDeliverySMS,
+		InitialDelay:        24 * time.Hour,
+		Recurring:           true,
+		RecurrenceFrequency: 7 * 24 * time.Hour,
+	})
+	if err != nil || updated.RecurrenceFrequency != 7*24*time.Hour {
+		t.Fatalf("UpdateAutoReminders failed: %v %+v", err, updated)
+	}
+
+	status, err := svc.AutoReminderStatus(ctx, "doc1")
+	if err != nil || len(status.Result) != 1 || status.Result[0].Status != ReminderStatusScheduled {
+		t.Fatalf("AutoReminderStatus failed: %v %+v", err, status)
+	}
+
+	sent, err := svc.SendReminder(ctx, "doc1", &SendReminderRequest{Reminders: []ManualReminder{{
+		RecipientID:        "r1",
+		DeliveryMethods:    &RecipientDeliveryMethods{Email: true, SMS: true},
+		EmailCustomization: &ReminderEmailCustomization{Subject: "Reminder", Message: "Please sign"},
+	}}})
+	if err != nil || len(sent.Result) != 1 || sent.Result[0].Email.Status != ReminderStatusSent || sent.Result[0].SMS.Detail == "" {
+		t.Fatalf("SendReminder failed: %v %+v", err, sent)
+	}
+}
+
+func TestAutoReminderSettings_JSON(t *testing.T) {
+	t.Parallel()
+
+	raw, err := json.Marshal(AutoReminderSettings{InitialDelay: 3 * 24 * time.Hour})
+	if err != nil || string(raw) != `{"enabled":false,"initial_delay_days":3,"is_recurring":false}` {
+		t.Fatalf("unexpected marshal: %s %v", raw, err)
+	}
+	if _, err = json.Marshal(AutoReminderSettings{InitialDelay: 36 * time.Hour}); !errors.Is(err, ErrInvalidReminderInterval) {
+		t.Fatalf("expected interval error from marshal, got %v", err)
+	}
+
+	var decoded AutoReminderSettings
+	if err = json.Unmarshal([]byte(`{"enabled":true,"recurrence_frequency_days":180}`), &decoded); err != nil || decoded.RecurrenceFrequency != 180*24*time.Hour {
+		t.Fatalf("unexpected unmarshal: %+v %v", decoded, err)
+	}
+	if err = json.Unmarshal([]byte(`{"enabled":"yes"}`), &decoded); err == nil {
+		t.Fatalf("expected unmarshal error")
+	}
+}
+
+func TestDocumentRemindersService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	svc := client.DocumentReminders()
+
+	if _, err := svc.GetAutoReminders(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+	if _, err := svc.AutoReminderStatus(ctx, " "); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+	if _, err := svc.UpdateAutoReminders(ctx, "doc1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	for _, d := range []time.Duration{time.Hour, 181 * 24 * time.Hour, -24 * time.Hour, 25 * time.Hour} {
+		_, err := svc.UpdateAutoReminders(ctx, "doc1", &AutoReminderSettings{Enabled: true, RecurrenceFrequency: d})
+		if !errors.Is(err, ErrInvalidReminderInterval) {
+			t.Fatalf("expected interval error for %s, got %v", d, err)
+		}
+	}
+	if _, err := svc.SendReminder(ctx, "doc1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if _, err := svc.SendReminder(ctx, "doc1", &SendReminderRequest{}); !errors.Is(err, ErrNoReminders) {
+		t.Fatalf("expected no reminders error, got %v", err)
+	}
+}
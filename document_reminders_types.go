@@ -0,0 +1,159 @@
+package pandadoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// reminderDay is the unit PandaDoc uses for auto-reminder intervals.
+const reminderDay = 24 * time.Hour
+
+// maxReminderInterval is the longest auto-reminder interval PandaDoc accepts.
+const maxReminderInterval = 180 * reminderDay
+
+// ReminderDeliveryMethod is the channel used for auto-reminders.
+type ReminderDeliveryMethod string
+
+// Supported reminder delivery methods.
+const (
+	// ReminderDeliveryEmail sends reminders by email.
+	ReminderDeliveryEmail ReminderDeliveryMethod = "email"
+	// ReminderDeliverySMS sends reminders by text message.
+	ReminderDeliverySMS ReminderDeliveryMethod = "sms"
+)
+
+// ReminderStatus is the state of a reminder for a recipient.
+type ReminderStatus string
+
+// Supported reminder statuses.
+const (
+	// ReminderStatusSent represents a reminder that was delivered.
+	ReminderStatusSent ReminderStatus = "sent"
+	// ReminderStatusError represents a reminder that could not be delivered.
+	ReminderStatusError ReminderStatus = "error"
+	// ReminderStatusScheduled represents a reminder waiting to be sent.
+	ReminderStatusScheduled ReminderStatus = "scheduled"
+)
+
+// AutoReminderSettings configures automatic reminders for a document.
+//
+// Intervals are whole days between 1 and 180; a zero interval is left unset.
+type AutoReminderSettings struct {
+	Enabled             bool
+	DeliveryMethod      ReminderDeliveryMethod
+	InitialDelay        time.Duration
+	Recurring           bool
+	RecurrenceFrequency time.Duration
+}
+
+// autoReminderSettingsJSON is the wire shape of AutoReminderSettings.
+type autoReminderSettingsJSON struct {
+	Enabled                 bool                   `json:"enabled"`
+	DeliveryMethod          ReminderDeliveryMethod `json:"delivery_method,omitempty"`
+	InitialDelayDays        int                    `json:"initial_delay_days,omitempty"`
+	IsRecurring             bool                   `json:"is_recurring"`
+	RecurrenceFrequencyDays int                    `json:"recurrence_frequency_days,omitempty"`
+}
+
+// MarshalJSON encodes intervals as whole days.
+func (s AutoReminderSettings) MarshalJSON() ([]byte, error) {
+	wire, err := s.toWire()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(wire)
+}
+
+// UnmarshalJSON decodes day-based intervals into durations.
+func (s *AutoReminderSettings) UnmarshalJSON(data []byte) error {
+	var wire autoReminderSettingsJSON
+	if err := json.Unmarshal(data, &wire); err != nil {
+		return err
+	}
+	*s = AutoReminderSettings{
+		Enabled:             wire.Enabled,
+		DeliveryMethod:      wire.DeliveryMethod,
+		InitialDelay:        time.Duration(wire.InitialDelayDays) * reminderDay,
+		Recurring:           wire.IsRecurring,
+		RecurrenceFrequency: time.Duration(wire.RecurrenceFrequencyDays) * reminderDay,
+	}
+	return nil
+}
+
+func (s AutoReminderSettings) toWire() (autoReminderSettingsJSON, error) {
+	initial, err := reminderDays(s.InitialDelay)
+	if err != nil {
+		return autoReminderSettingsJSON{}, fmt.Errorf("initial delay: %w", err)
+	}
+	recurrence, err := reminderDays(s.RecurrenceFrequency)
+	if err != nil {
+		return autoReminderSettingsJSON{}, fmt.Errorf("recurrence frequency: %w", err)
+	}
+	return autoReminderSettingsJSON{
+		Enabled:                 s.Enabled,
+		DeliveryMethod:          s.DeliveryMethod,
+		InitialDelayDays:        initial,
+		IsRecurring:             s.Recurring,
+		RecurrenceFrequencyDays: recurrence,
+	}, nil
+}
+
+func reminderDays(d time.Duration) (int, error) {
+	if d == 0 {
+		return 0, nil
+	}
+	if d < reminderDay || d > maxReminderInterval || d%reminderDay != 0 {
+		return 0, fmt.Errorf("%w: %s", ErrInvalidReminderInterval, d)
+	}
+	return int(d / reminderDay), nil
+}
+
+// RecipientReminderStatus is the auto-reminder state of one recipient.
+type RecipientReminderStatus struct {
+	RecipientID string         `json:"recipient_id"`
+	Status      ReminderStatus `json:"status,omitempty"`
+}
+
+// AutoReminderStatusResponse is returned by the auto-reminder status endpoint.
+type AutoReminderStatusResponse struct {
+	Result []RecipientReminderStatus `json:"result"`
+}
+
+// ReminderEmailCustomization overrides the subject and body of a reminder email.
+type ReminderEmailCustomization struct {
+	Subject string `json:"subject,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+// ManualReminder targets one recipient with a reminder.
+type ManualReminder struct {
+	RecipientID        string                      `json:"recipient_id"`
+	DeliveryMethods    *RecipientDeliveryMethods   `json:"delivery_methods,omitempty"`
+	EmailCustomization *ReminderEmailCustomization `json:"email_customization,omitempty"`
+}
+
+// SendReminderRequest sends reminders to document recipients.
+type SendReminderRequest struct {
+	Reminders []ManualReminder `json:"reminders"`
+}
+
+// ReminderDeliveryResult is the outcome of a reminder on one channel.
+type ReminderDeliveryResult struct {
+	Status ReminderStatus `json:"status,omitempty"`
+	Detail string         `json:"detail,omitempty"`
+	SentAt string         `json:"sent_at,omitempty"`
+}
+
+// ManualReminderResult is the outcome of a reminder for one recipient.
+type ManualReminderResult struct {
+	RecipientID        string                      `json:"recipient_id,omitempty"`
+	Email              *ReminderDeliveryResult     `json:"email,omitempty"`
+	SMS                *ReminderDeliveryResult     `json:"sms,omitempty"`
+	EmailCustomization *ReminderEmailCustomization `json:"email_customization,omitempty"`
+}
+
+// SendReminderResponse is returned by the send-reminder endpoint.
+type SendReminderResponse struct {
+	Result []ManualReminderResult `json:"result"`
+}
@@ -0,0 +1,202 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// documentSectionsService implements DocumentSectionsService.
+type documentSectionsService struct {
+	client *Client
+}
+
+// List lists sections of a document.
+func (s *documentSectionsService) List(ctx context.Context, documentID string) (*DocumentSectionListResponse, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out DocumentSectionListResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/documents/" + escapedID + "/sections",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Upload starts an asynchronous section upload from a template or URL payload.
+func (s *documentSectionsService) Upload(ctx context.Context, documentID string, reqBody DocumentSectionUploadRequest, opts *UploadSectionOptions) (*DocumentSectionUpload, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	query := url.Values{}
+	if opts != nil {
+		setIfNotEmpty(query, "merge_field_scope", string(opts.MergeFieldScope))
+	}
+
+	var out DocumentSectionUpload
+	err = s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPost,
+		path:           "/public/v1/documents/" + escapedID + "/sections/uploads",
+		query:          query,
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UploadFromFile starts an asynchronous section upload from a file.
+func (s *documentSectionsService) UploadFromFile(ctx context.Context, documentID string, reqBody *UploadDocumentSectionFromFileRequest) (*DocumentSectionUpload, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+	if reqBody.File == nil {
+		return nil, ErrNilFileReader
+	}
+
+	fieldName := reqBody.FileField
+	if fieldName == "" {
+		fieldName = "file"
+	}
+	query := url.Values{}
+	setIfNotEmpty(query, "merge_field_scope", string(reqBody.MergeFieldScope))
+
+	var out DocumentSectionUpload
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/documents/" + escapedID + "/sections/uploads?upload",
+		query:       query,
+		requireAuth: true,
+		multipart: &multipartPayload{
+			Fields: reqBody.Fields,
+			Files: []multipartFile{{
+				FieldName:   fieldName,
+				FileName:    reqBody.FileName,
+				ContentType: reqBody.ContentType,
+				Reader:      reqBody.File,
+			}},
+		},
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UploadDetails returns the processing state of a section upload.
+func (s *documentSectionsService) UploadDetails(ctx context.Context, documentID, uploadID string) (*DocumentSectionUpload, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	escapedUploadID, err := escapePathParam(uploadID)
+	if err != nil {
+		return nil, fmt.Errorf("upload id: %w", err)
+	}
+
+	var out DocumentSectionUpload
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/documents/" + escapedID + "/sections/uploads/" + escapedUploadID,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// WaitForUpload polls a section upload until it is processed.
+//
+// Polls are spaced by the client's RetryPolicy backoff unless opts overrides it,
+// and stop when ctx is done. An upload that ends in the error state returns ErrSectionUploadFailed.
+func (s *documentSectionsService) WaitForUpload(ctx context.Context, documentID, uploadID string, opts *PollOptions) (*DocumentSectionUpload, error) {
+	var last *DocumentSectionUpload
+	err := s.client.poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		details, err := s.UploadDetails(ctx, documentID, uploadID)
+		if err != nil {
+			return false, err
+		}
+		last = details
+		switch details.Status {
+		case SectionUploadStatusProcessed:
+			return true, nil
+		case SectionUploadStatusError:
+			return false, fmt.Errorf("%w: %s", ErrSectionUploadFailed, uploadID)
+		case SectionUploadStatusUploaded:
+			// still processing
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return last, nil
+}
+
+// Get returns section info.
+func (s *documentSectionsService) Get(ctx context.Context, documentID, sectionID string) (*DocumentSection, error) {
+	path, err := sectionPath(documentID, sectionID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out DocumentSection
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        path,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete deletes a section from a document.
+func (s *documentSectionsService) Delete(ctx context.Context, documentID, sectionID string) error {
+	path, err := sectionPath(documentID, sectionID)
+	if err != nil {
+		return err
+	}
+
+	return s.client.decodeJSON(ctx, &request{
+		method:         http.MethodDelete,
+		path:           path,
+		requireAuth:    true,
+		expectedStatus: []int{http.StatusNoContent},
+	}, nil)
+}
+
+func sectionPath(documentID, sectionID string) (string, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return "", err
+	}
+	escapedSectionID, err := escapePathParam(sectionID)
+	if err != nil {
+		return "", fmt.Errorf("section id: %w", err)
+	}
+	return "/public/v1/documents/" + escapedID + "/sections/" + escapedSectionID, nil
+}
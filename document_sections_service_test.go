@@ -0,0 +1,174 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+//nolint:gocognit // Test function that validates all section methods
+func TestDocumentSectionsService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	var polls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/doc1/sections":
+			_, _ = io.WriteString(w, `{"results":[{"uuid":"s1","name":"Main"}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/sections/uploads" && r.URL.Query().Has("upload"):
+			mediaType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+			if err != nil || mediaType != "multipart/form-data" {
+				t.Fatalf("expected multipart, got %s", r.Header.Get("Content-Type"))
+			}
+			if r.URL.Query().Get("merge_field_scope") != "" {
+				t.Fatalf("unexpected merge_field_scope: %s", r.URL.RawQuery)
+			}
+			if err = r.ParseMultipartForm(1 << 20); err != nil || r.FormValue("data") != `{"name":"Appendix"}` {
+				t.Fatalf("unexpected multipart form: %v", err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"uuid":"u2","status":"document_sections_upload.UPLOADED"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/sections/uploads":
+			assertQueryEq(t, r.URL.Query(), "merge_field_scope", "upload")
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if payload["template_uuid"] != "tpl1" {
+				t.Fatalf("unexpected upload payload: %+v", payload)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"uuid":"u1","document_uuid":"doc1","status":"document_sections_upload.UPLOADED"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/doc1/sections/uploads/u1":
+			if polls.Add(1) < 3 {
+				_, _ = io.WriteString(w, `{"uuid":"u1","status":"document_sections_upload.UPLOADED"}`)
+				return
+			}
+			_, _ = io.WriteString(w, `{"uuid":"u1","status":"document_sections_upload.PROCESSED","sections_uuids":["s2"]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/doc1/sections/s1":
+			_, _ = io.WriteString(w, `{"uuid":"s1","name":"Main","document_uuid":"doc1"}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/public/v1/documents/doc1/sections/s1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.String())
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.DocumentSections()
+
+	list, err := svc.List(ctx, "doc1")
+	if err != nil || len(list.Results) != 1 || list.Results[0].UUID != "s1" {
+		t.Fatalf("List failed: %v %+v", err, list)
+	}
+
+	upload, err := svc.Upload(ctx, "doc1", DocumentSectionUploadRequest{"template_uuid": "tpl1"}, &UploadSectionOptions{MergeFieldScope: SectionMergeFieldScopeUpload})
+	if err != nil || upload.UUID != "u1" || upload.Status != SectionUploadStatusUploaded {
+		t.Fatalf("Upload failed: %v %+v", err, upload)
+	}
+
+	fromFile, err := svc.UploadFromFile(ctx, "doc1", &UploadDocumentSectionFromFileRequest{
+		FileName: "appendix.pdf",
+		File:     strings.NewReader("PDF"),
+		Fields:   map[string]string{"data": `{"name":"Appendix"}`},
+	})
+	if err != nil || fromFile.UUID != "u2" {
+		t.Fatalf("UploadFromFile failed: %v %+v", err, fromFile)
+	}
+
+	done, err := svc.WaitForUpload(ctx, "doc1", "u1", nil)
+	if err != nil || done.Status != SectionUploadStatusProcessed || len(done.SectionsUUIDs) != 1 || polls.Load() != 3 {
+		t.Fatalf("WaitForUpload failed: %v %+v (polls=%d)", err, done, polls.Load())
+	}
+
+	info, err := svc.Get(ctx, "doc1", "s1")
+	if err != nil || info.DocumentUUID != "doc1" {
+		t.Fatalf("Get failed: %v %+v", err, info)
+	}
+
+	if err = svc.Delete(ctx, "doc1", "s1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+}
+
+func TestDocumentSectionsService_WaitForUpload(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/public/v1/documents/doc1/sections/uploads/failed":
+			_, _ = io.WriteString(w, `{"uuid":"failed","status":"document_sections_upload.ERROR"}`)
+		case "/public/v1/documents/doc1/sections/uploads/pending":
+			_, _ = io.WriteString(w, `{"uuid":"pending","status":"document_sections_upload.UPLOADED"}`)
+		case "/public/v1/documents/doc1/sections/uploads/missing":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"type":"not_found","detail":"Not found"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.DocumentSections()
+
+	if _, err := svc.WaitForUpload(ctx, "doc1", "failed", nil); !errors.Is(err, ErrSectionUploadFailed) {
+		t.Fatalf("expected upload failed error, got %v", err)
+	}
+	if _, err := svc.WaitForUpload(ctx, "doc1", "pending", &PollOptions{MaxAttempts: 2}); !errors.Is(err, ErrPollAttemptsExhausted) {
+		t.Fatalf("expected attempts exhausted error, got %v", err)
+	}
+	if _, err := svc.WaitForUpload(ctx, "doc1", "missing", nil); !IsNotFound(err) {
+		t.Fatalf("expected not found error, got %v", err)
+	}
+
+	cancelCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	if _, err := svc.WaitForUpload(cancelCtx, "doc1", "pending", &PollOptions{Interval: 5 * time.Millisecond}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+}
+
+func TestDocumentSectionsService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	svc := client.DocumentSections()
+
+	if _, err := svc.List(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+	if _, err := svc.Upload(ctx, "doc1", nil, nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if _, err := svc.UploadFromFile(ctx, "doc1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if _, err := svc.UploadFromFile(ctx, "doc1", &UploadDocumentSectionFromFileRequest{}); !errors.Is(err, ErrNilFileReader) {
+		t.Fatalf("expected nil file error, got %v", err)
+	}
+	if _, err := svc.UploadDetails(ctx, "doc1", ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty upload id error, got %v", err)
+	}
+	if _, err := svc.WaitForUpload(ctx, "", "u1", nil); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty document id error, got %v", err)
+	}
+	if _, err := svc.Get(ctx, "doc1", ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty section id error, got %v", err)
+	}
+	if err := svc.Delete(ctx, "", "s1"); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty document id error, got %v", err)
+	}
+}
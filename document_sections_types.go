@@ -0,0 +1,75 @@
+package pandadoc
+
+import "io"
+
+// SectionUploadStatus is the processing state of a section upload.
+type SectionUploadStatus string
+
+// Supported section upload statuses.
+const (
+	// SectionUploadStatusUploaded represents an upload that is still being processed.
+	SectionUploadStatusUploaded SectionUploadStatus = "document_sections_upload.UPLOADED"
+	// SectionUploadStatusProcessed represents an upload that was added to the document.
+	SectionUploadStatusProcessed SectionUploadStatus = "document_sections_upload.PROCESSED"
+	// SectionUploadStatusError represents an upload that failed processing.
+	SectionUploadStatusError SectionUploadStatus = "document_sections_upload.ERROR"
+)
+
+// SectionMergeFieldScope controls whether uploaded merge fields join the document or stay local to the upload.
+type SectionMergeFieldScope string
+
+// Supported merge field scopes.
+const (
+	// SectionMergeFieldScopeDocument merges the upload's fields into the document's fields.
+	SectionMergeFieldScopeDocument SectionMergeFieldScope = "document"
+	// SectionMergeFieldScopeUpload keeps the upload's fields local to the new section.
+	SectionMergeFieldScopeUpload SectionMergeFieldScope = "upload"
+)
+
+// DocumentSection is a section (bundle part) of a document.
+type DocumentSection struct {
+	UUID         string `json:"uuid,omitempty"`
+	Name         string `json:"name,omitempty"`
+	DocumentUUID string `json:"document_uuid,omitempty"`
+}
+
+// DocumentSectionListResponse is returned by list sections endpoint.
+type DocumentSectionListResponse struct {
+	Results []DocumentSection `json:"results"`
+}
+
+// DocumentSectionUploadRequest is a flexible section upload payload.
+//
+// It accepts the same template, URL, and recipient keys as DocumentCreateRequest.
+type DocumentSectionUploadRequest map[string]any
+
+// UploadSectionOptions are optional query parameters for section uploads.
+type UploadSectionOptions struct {
+	MergeFieldScope SectionMergeFieldScope
+}
+
+// UploadDocumentSectionFromFileRequest uploads a file as a new document section.
+//
+// ContentType sets the file part's Content-Type; FileName's extension is used
+// when it is empty.
+type UploadDocumentSectionFromFileRequest struct {
+	MergeFieldScope SectionMergeFieldScope
+	FileField       string
+	FileName        string
+	ContentType     string
+	File            io.Reader
+	Fields          map[string]string
+}
+
+// DocumentSectionUpload describes an asynchronous section upload.
+type DocumentSectionUpload struct {
+	UUID          string              `json:"uuid,omitempty"`
+	Name          string              `json:"name,omitempty"`
+	DocumentUUID  string              `json:"document_uuid,omitempty"`
+	Status        SectionUploadStatus `json:"status,omitempty"`
+	InfoMessage   string              `json:"info_message,omitempty"`
+	DateCreated   string              `json:"date_created,omitempty"`
+	DateModified  string              `json:"date_modified,omitempty"`
+	DateCompleted *string             `json:"date_completed,omitempty"`
+	SectionsUUIDs []string            `json:"sections_uuids,omitempty"`
+}
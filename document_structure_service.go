@@ -0,0 +1,37 @@
+package pandadoc
+
+import (
+	"context"
+	"net/http"
+)
+
+// documentStructureService implements DocumentStructureService.
+type documentStructureService struct {
+	client *Client
+}
+
+// AddNamedItems adds named items to a document's Document Structure View.
+func (s *documentStructureService) AddNamedItems(ctx context.Context, documentID string, reqBody *AddDSVNamedItemsRequest) (*AddDSVNamedItemsResponse, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+	if len(reqBody.Items) == 0 {
+		return nil, ErrNoDSVNamedItems
+	}
+
+	var out AddDSVNamedItemsResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v2/dsv/" + escapedID + "/add-named-items",
+		requireAuth: true,
+		jsonBody:    reqBody,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,134 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestDocumentStructureService_AddNamedItems(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/public/v2/dsv/doc1/add-named-items" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var payload AddDSVNamedItemsRequest
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		if len(payload.Items) != 2 || payload.Items[1] != (DSVNamedItem{Name: "Pricing_2", Level: 1, PageName: "Pricing"}) {
+			t.Fatalf("unexpected payload: %+v", payload)
+		}
+		_, _ = io.WriteString(w, `{"count":2,"results":[
+			{"id":"0da526b8-0535-4a5c-9913-431ed252a6f5","level":1,"name":"Intro_Text","page_name":"Pricing","page_uuid":"8dcc8dcc-b5bd-4431-9885-1a60e829cdaf"},
+			{"id":"30dc3914-fd05-4b09-9eca-99a067b8767b","level":1,"name":"Pricing_2","page_name":"Pricing","page_uuid":"8dcc8dcc-b5bd-4431-9885-1a60e829cdaf"}
+		]}`)
+	})
+
+	mapping := ContentBlockMapping{ContentBlockTexts: {"Pricing": "Pricing_2", "Intro_Text": "Intro_Text"}}
+	resp, err := client.DocumentStructure().AddNamedItems(context.Background(), "doc1", &AddDSVNamedItemsRequest{
+		Items: NewDSVNamedItems("Pricing", 1, mapping.Blocks(ContentBlockTexts)...),
+	})
+	if err != nil || resp.Count != 2 {
+		t.Fatalf("AddNamedItems failed: %v %+v", err, resp)
+	}
+
+	item, ok := resp.Find(mapping.Rename(ContentBlockTexts, "Pricing"))
+	if !ok || item.PageUUID == "" || item.Level != 1 || item.Name != "Pricing_2" {
+		t.Fatalf("unexpected found item: %v %+v", ok, item)
+	}
+	if _, ok = resp.Find("Missing"); ok {
+		t.Fatalf("expected missing item not to be found")
+	}
+	var missing *AddDSVNamedItemsResponse
+	if _, ok = missing.Find("Pricing_2"); ok {
+		t.Fatalf("expected nil response not to find items")
+	}
+}
+
+func TestDocumentStructureService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	svc := client.DocumentStructure()
+	if _, err := svc.AddNamedItems(ctx, "", &AddDSVNamedItemsRequest{}); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty id error, got %v", err)
+	}
+	if _, err := svc.AddNamedItems(ctx, "doc1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if _, err := svc.AddNamedItems(ctx, "doc1", &AddDSVNamedItemsRequest{}); !errors.Is(err, ErrNoDSVNamedItems) {
+		t.Fatalf("expected no items error, got %v", err)
+	}
+}
+
+func TestAppendContentLibraryItemResponse_BlockMappingAndPages(t *testing.T) {
+	t.Parallel()
+
+	var resp AppendContentLibraryItemResponse
+	err := json.Unmarshal([]byte(`{
+		"block_mapping":{
+			"images":[{"new_name":"Hero_Image_2","original_name":"Hero_Image"}],
+			"pricing_tables":[{"new_name":"Services_Quote","original_name":"Services_Quote"},{"new_name":"Monthly_Plans_2","original_name":"Monthly_Plans"}],
+			"texts":[{"new_name":"Legal_Disclaimer","original_name":"Legal_Disclaimer"}]
+		},
+		"cli":{"id":"cli1","pages":[{"index":0,"name":"Introduction"},{"index":1,"name":"Pricing"}]}
+	}`), &resp)
+	if err != nil {
+		t.Fatalf("unmarshal failed: %v", err)
+	}
+	mapping := resp.BlockMapping
+	if mapping.Rename(ContentBlockImages, "Hero_Image") != "Hero_Image_2" || mapping.Rename(ContentBlockImages, "Unmapped") != "Unmapped" ||
+		mapping.Rename(ContentBlockTexts, "Hero_Image") != "Hero_Image" {
+		t.Fatalf("unexpected mapping: %+v", mapping)
+	}
+	if got := fmt.Sprint(mapping.Blocks(ContentBlockPricingTables)); got != "[{Monthly_Plans_2} {Services_Quote}]" {
+		t.Fatalf("unexpected blocks: %s", got)
+	}
+	if got := mapping.Blocks(ContentBlockTables); len(got) != 0 {
+		t.Fatalf("expected no table blocks, got %v", got)
+	}
+
+	encoded, err := json.Marshal(mapping)
+	if err != nil {
+		t.Fatalf("marshal failed: %v", err)
+	}
+	want := `{"images":[{"original_name":"Hero_Image","new_name":"Hero_Image_2"}],` +
+		`"pricing_tables":[{"original_name":"Monthly_Plans","new_name":"Monthly_Plans_2"},{"original_name":"Services_Quote","new_name":"Services_Quote"}],` +
+		`"texts":[{"original_name":"Legal_Disclaimer","new_name":"Legal_Disclaimer"}]}`
+	if string(encoded) != want {
+		t.Fatalf("unexpected encoded mapping: %s", encoded)
+	}
+	var decoded ContentBlockMapping
+	if err = json.Unmarshal(encoded, &decoded); err != nil || !reflect.DeepEqual(decoded, mapping) {
+		t.Fatalf("expected mapping to round-trip: %v %+v", err, decoded)
+	}
+
+	pages, err := resp.Pages()
+	if err != nil || len(pages) != 2 || pages[1] != (ContentLibraryPage{Index: 1, Name: "Pricing"}) {
+		t.Fatalf("unexpected pages: %v %+v", err, pages)
+	}
+	if pages, err = (&AppendContentLibraryItemResponse{}).Pages(); err != nil || pages != nil {
+		t.Fatalf("expected no pages: %v %+v", err, pages)
+	}
+	if _, err = (&AppendContentLibraryItemResponse{CLI: RawObject{"pages": "bad"}}).Pages(); err == nil {
+		t.Fatalf("expected pages decode error")
+	}
+
+	var bad ContentBlockMapping
+	if err = json.Unmarshal([]byte(`["nope"]`), &bad); err == nil {
+		t.Fatalf("expected block mapping decode error")
+	}
+}
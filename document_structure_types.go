@@ -0,0 +1,56 @@
+package pandadoc
+
+// DSVNamedItem is a structural item (such as a section heading) shown in the
+// Document Structure View.
+//
+// Level 0 is the top level; nested items use higher levels. PageName refers to
+// a page of the document, such as a ContentLibraryPage name.
+type DSVNamedItem struct {
+	Name     string `json:"name"`
+	Level    int    `json:"level"`
+	PageName string `json:"page_name"`
+}
+
+// NewDSVNamedItems builds one item per content block, all on the same page and level.
+//
+// It accepts the blocks of DocumentDetailsResponse (Texts, Tables, Images) or
+// ContentBlockMapping.Blocks after appending a content library item.
+func NewDSVNamedItems(pageName string, level int, blocks ...NamedContentBlock) []DSVNamedItem {
+	items := make([]DSVNamedItem, 0, len(blocks))
+	for _, block := range blocks {
+		items = append(items, DSVNamedItem{Name: block.Name, Level: level, PageName: pageName})
+	}
+	return items
+}
+
+// AddDSVNamedItemsRequest models add DSV named items payload.
+type AddDSVNamedItemsRequest struct {
+	Items []DSVNamedItem `json:"items"`
+}
+
+// DSVNamedItemResult models a DSV named item created on a document.
+type DSVNamedItemResult struct {
+	DSVNamedItem
+
+	ID       string `json:"id"`
+	PageUUID string `json:"page_uuid"`
+}
+
+// AddDSVNamedItemsResponse models add DSV named items response.
+type AddDSVNamedItemsResponse struct {
+	Count   int                  `json:"count"`
+	Results []DSVNamedItemResult `json:"results"`
+}
+
+// Find returns the created item with the given name.
+func (r *AddDSVNamedItemsResponse) Find(name string) (DSVNamedItemResult, bool) {
+	if r == nil {
+		return DSVNamedItemResult{}, false
+	}
+	for _, item := range r.Results {
+		if item.Name == name {
+			return item, true
+		}
+	}
+	return DSVNamedItemResult{}, false
+}
@@ -140,9 +140,10 @@ func (s *documentsService) CreateFromUpload(ctx context.Context, reqBody *Create
 		multipart: &multipartPayload{
 			Fields: reqBody.Fields,
 			Files: []multipartFile{{
-				FieldName: fieldName,
-				FileName:  reqBody.FileName,
-				Reader:    reqBody.File,
+				FieldName:   fieldName,
+				FileName:    reqBody.FileName,
+				ContentType: reqBody.ContentType,
+				Reader:      reqBody.File,
 			}},
 		},
 		expectedStatus: []int{http.StatusCreated},
@@ -284,9 +285,10 @@ func (s *documentsService) ChangeStatusWithUpload(ctx context.Context, id string
 		multipart: &multipartPayload{
 			Fields: fields,
 			Files: []multipartFile{{
-				FieldName: fieldName,
-				FileName:  reqBody.FileName,
-				Reader:    reqBody.File,
+				FieldName:   fieldName,
+				FileName:    reqBody.FileName,
+				ContentType: reqBody.ContentType,
+				Reader:      reqBody.File,
 			}},
 		},
 		expectedStatus: []int{http.StatusNoContent},
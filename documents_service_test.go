@@ -251,7 +251,7 @@ func TestDocumentsService_OtherJSONOperations(t *testing.T) {
 			_, _ = io.WriteString(w, `{"id":"sess1"}`)
 		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/append-content-library-item":
 			w.WriteHeader(http.StatusCreated)
-			_, _ = io.WriteString(w, `{"block_mapping":{"a":"b"}}`)
+			_, _ = io.WriteString(w, `{"block_mapping":{"texts":[{"original_name":"a","new_name":"b"}]}}`)
 		default:
 			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
 		}
@@ -286,7 +286,7 @@ func TestDocumentsService_OtherJSONOperations(t *testing.T) {
 	if err != nil {
 		t.Fatalf("AppendContentLibraryItem failed: %v", err)
 	}
-	if appendResp.BlockMapping["a"] != "b" {
+	if appendResp.BlockMapping.Rename(ContentBlockTexts, "a") != "b" {
 		t.Fatalf("unexpected append response: %+v", appendResp)
 	}
 }
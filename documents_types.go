@@ -1,6 +1,12 @@
 package pandadoc
 
-import "io"
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
 
 // DocumentStatusCode is the numeric status code used in some document requests.
 type DocumentStatusCode int
@@ -120,6 +126,7 @@ type DocumentSummary struct {
 	DateCompleted  string `json:"date_completed,omitempty"`
 	ExpirationDate string `json:"expiration_date,omitempty"`
 	Version        string `json:"version,omitempty"`
+	TemplateID     string `json:"template_id,omitempty"`
 }
 
 // DocumentListResponse is returned by list/search documents endpoint.
@@ -156,15 +163,18 @@ type DocumentRevertToDraftResponse = DocumentSummary
 
 // DocumentField represents a field entry in document details.
 type DocumentField struct {
-	UUID        string  `json:"uuid,omitempty"`
-	Name        string  `json:"name,omitempty"`
-	Title       string  `json:"title,omitempty"`
-	MergeField  string  `json:"merge_field,omitempty"`
-	Placeholder string  `json:"placeholder,omitempty"`
-	FieldID     string  `json:"field_id,omitempty"`
-	Type        string  `json:"type,omitempty"`
-	Value       any     `json:"value,omitempty"`
-	AssignedTo  RawJSON `json:"assigned_to,omitempty"`
+	UUID        string       `json:"uuid,omitempty"`
+	Name        string       `json:"name,omitempty"`
+	Title       string       `json:"title,omitempty"`
+	MergeField  string       `json:"merge_field,omitempty"`
+	Placeholder string       `json:"placeholder,omitempty"`
+	FieldID     string       `json:"field_id,omitempty"`
+	Type        string       `json:"type,omitempty"`
+	Value       any          `json:"value,omitempty"`
+	AssignedTo  RawJSON      `json:"assigned_to,omitempty"`
+	SectionUUID string       `json:"section_uuid,omitempty"`
+	Layout      *FieldLayout `json:"layout,omitempty"`
+	Settings    RawJSON      `json:"settings,omitempty"`
 }
 
 // DocumentToken is a token/value pair from document details.
@@ -187,8 +197,16 @@ type DocumentRecipient struct {
 
 // LinkedObject represents a linked CRM object reference.
 type LinkedObject struct {
+	ID         string              `json:"id,omitempty"`
+	Provider   string              `json:"provider,omitempty"`
+	EntityType string              `json:"entity_type,omitempty"`
+	EntityID   string              `json:"entity_id,omitempty"`
+	Children   []LinkedObjectChild `json:"children,omitempty"`
+}
+
+// LinkedObjectChild is a nested CRM entity under a linked object.
+type LinkedObjectChild struct {
 	ID         string `json:"id,omitempty"`
-	Provider   string `json:"provider,omitempty"`
 	EntityType string `json:"entity_type,omitempty"`
 	EntityID   string `json:"entity_id,omitempty"`
 }
@@ -231,6 +249,18 @@ type DocumentDetailsResponse struct {
 	Version                         string                     `json:"version,omitempty"`
 }
 
+// PricingDetails decodes the document's pricing tables and quotes.
+func (d *DocumentDetailsResponse) PricingDetails() (*TemplatePricing, error) {
+	var out TemplatePricing
+	if len(d.Pricing) == 0 {
+		return &out, nil
+	}
+	if err := json.Unmarshal(d.Pricing, &out); err != nil {
+		return nil, fmt.Errorf("decode pricing: %w", err)
+	}
+	return &out, nil
+}
+
 // DocumentESignDisclosureResponse models e-sign disclosure settings for a document.
 type DocumentESignDisclosureResponse struct {
 	Result *DocumentESignDisclosure `json:"result,omitempty"`
@@ -251,22 +281,30 @@ type ChangeDocumentStatusRequest struct {
 }
 
 // ChangeDocumentStatusWithUploadRequest changes status with multipart payload.
+//
+// ContentType sets the file part's Content-Type; when empty it is inferred
+// from FileName.
 type ChangeDocumentStatusWithUploadRequest struct {
 	Status           DocumentStatusCode
 	Note             string
 	NotifyRecipients *bool
 	FileField        string
 	FileName         string
+	ContentType      string
 	File             io.Reader
 	Fields           map[string]string
 }
 
 // CreateDocumentFromUploadRequest uploads a file and creates a document.
+//
+// ContentType overrides the file part's Content-Type, which is otherwise
+// inferred from FileName.
 type CreateDocumentFromUploadRequest struct {
-	FileField string
-	FileName  string
-	File      io.Reader
-	Fields    map[string]string
+	FileField   string
+	FileName    string
+	ContentType string
+	File        io.Reader
+	Fields      map[string]string
 }
 
 // DocumentSendRequest is a flexible send payload.
@@ -312,6 +350,107 @@ type AppendContentLibraryItemRequest map[string]any
 
 // AppendContentLibraryItemResponse models append-content response.
 type AppendContentLibraryItemResponse struct {
-	BlockMapping map[string]string `json:"block_mapping,omitempty"`
-	CLI          RawObject         `json:"cli,omitempty"`
+	BlockMapping ContentBlockMapping `json:"block_mapping,omitempty"`
+	CLI          RawObject           `json:"cli,omitempty"`
+}
+
+// ContentLibraryPage is a page added to a document from a content library item.
+type ContentLibraryPage struct {
+	Index int    `json:"index"`
+	Name  string `json:"name"`
+}
+
+// Pages decodes the pages the appended content library item added to the document.
+func (r *AppendContentLibraryItemResponse) Pages() ([]ContentLibraryPage, error) {
+	raw, ok := r.CLI["pages"]
+	if !ok {
+		return nil, nil
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, fmt.Errorf("encode pages: %w", err)
+	}
+	var pages []ContentLibraryPage
+	if err = json.Unmarshal(data, &pages); err != nil {
+		return nil, fmt.Errorf("decode pages: %w", err)
+	}
+	return pages, nil
+}
+
+// ContentBlockKind identifies a kind of content block in a ContentBlockMapping.
+type ContentBlockKind string
+
+// Content block kind constants.
+const (
+	// ContentBlockImages identifies image blocks.
+	ContentBlockImages ContentBlockKind = "images"
+	// ContentBlockTables identifies table blocks.
+	ContentBlockTables ContentBlockKind = "tables"
+	// ContentBlockTexts identifies text blocks.
+	ContentBlockTexts ContentBlockKind = "texts"
+	// ContentBlockPricingTables identifies pricing table blocks.
+	ContentBlockPricingTables ContentBlockKind = "pricing_tables"
+)
+
+// ContentBlockMapping maps content block names from a content library item to
+// the names they were given in the document, which may carry a suffix to stay
+// unique. Names are kept per kind because blocks of different kinds may share a name.
+type ContentBlockMapping map[ContentBlockKind]map[string]string
+
+// contentBlockRename is one {original_name, new_name} pair of a block mapping.
+type contentBlockRename struct {
+	OriginalName string `json:"original_name"`
+	NewName      string `json:"new_name"`
+}
+
+// UnmarshalJSON decodes PandaDoc's per-kind lists of {original_name, new_name} pairs.
+func (m *ContentBlockMapping) UnmarshalJSON(data []byte) error {
+	var byKind map[ContentBlockKind][]contentBlockRename
+	if err := json.Unmarshal(data, &byKind); err != nil {
+		return fmt.Errorf("decode block mapping: %w", err)
+	}
+	out := make(ContentBlockMapping, len(byKind))
+	for kind, pairs := range byKind {
+		names := make(map[string]string, len(pairs))
+		for _, pair := range pairs {
+			names[pair.OriginalName] = pair.NewName
+		}
+		out[kind] = names
+	}
+	*m = out
+	return nil
+}
+
+// MarshalJSON encodes the mapping in the shape PandaDoc returns, with each
+// kind's pairs sorted by original name.
+func (m ContentBlockMapping) MarshalJSON() ([]byte, error) {
+	byKind := make(map[ContentBlockKind][]contentBlockRename, len(m))
+	for kind, names := range m {
+		pairs := make([]contentBlockRename, 0, len(names))
+		for original, renamed := range names {
+			pairs = append(pairs, contentBlockRename{OriginalName: original, NewName: renamed})
+		}
+		slices.SortFunc(pairs, func(a, b contentBlockRename) int { return strings.Compare(a.OriginalName, b.OriginalName) })
+		byKind[kind] = pairs
+	}
+	return json.Marshal(byKind)
+}
+
+// Rename returns the document name of a content block of the given kind, or
+// original if it was not renamed.
+func (m ContentBlockMapping) Rename(kind ContentBlockKind, original string) string {
+	if name, ok := m[kind][original]; ok && name != "" {
+		return name
+	}
+	return original
+}
+
+// Blocks returns the mapped blocks of the given kind under their document names, sorted by name.
+func (m ContentBlockMapping) Blocks(kind ContentBlockKind) []NamedContentBlock {
+	blocks := make([]NamedContentBlock, 0, len(m[kind]))
+	for _, name := range m[kind] {
+		blocks = append(blocks, NamedContentBlock{Name: name})
+	}
+	slices.SortFunc(blocks, func(a, b NamedContentBlock) int { return strings.Compare(a.Name, b.Name) })
+	return blocks
 }
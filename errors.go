@@ -27,6 +27,87 @@ var (
 
 	// ErrNilFileReader indicates an upload request has no file reader.
 	ErrNilFileReader = stderrors.New("file reader is required")
+
+	// ErrEmptyFolderPath indicates a folder path had no non-empty segments.
+	ErrEmptyFolderPath = stderrors.New("folder path cannot be empty")
+
+	// ErrFolderNotFound indicates a folder path segment does not exist.
+	ErrFolderNotFound = stderrors.New("folder not found")
+
+	// ErrRecipientTargetRequired indicates a recipient request has neither a contact ID nor an email.
+	ErrRecipientTargetRequired = stderrors.New("recipient contact id or email is required")
+
+	// ErrContactGroupIDRequired indicates a contact group recipient was given by email instead of ID.
+	ErrContactGroupIDRequired = stderrors.New("contact group recipients require a contact group id")
+
+	// ErrContactNotFound indicates no contact matched a lookup.
+	ErrContactNotFound = stderrors.New("contact not found")
+
+	// ErrAttachmentSourceRequired indicates an attachment request has no file or source URL.
+	ErrAttachmentSourceRequired = stderrors.New("attachment file or source URL is required")
+
+	// ErrMultipleAttachmentSources indicates an attachment request has both a file and a source URL.
+	ErrMultipleAttachmentSources = stderrors.New("only one attachment source can be set")
+
+	// ErrNoFields indicates a fields request contains no fields.
+	ErrNoFields = stderrors.New("at least one field is required")
+
+	// ErrFieldTypeRequired indicates a new field has no type.
+	ErrFieldTypeRequired = stderrors.New("field type is required")
+
+	// ErrInvalidFieldLayout indicates a new field has no page or a non-positive size.
+	ErrInvalidFieldLayout = stderrors.New("field layout requires a page and positive width and height")
+
+	// ErrPollAttemptsExhausted indicates a wait helper ran out of attempts before the operation finished.
+	ErrPollAttemptsExhausted = stderrors.New("operation did not finish within the allowed poll attempts")
+
+	// ErrSectionUploadFailed indicates PandaDoc could not process a section upload.
+	ErrSectionUploadFailed = stderrors.New("section upload failed")
+
+	// ErrInvalidReminderInterval indicates a reminder interval is not a whole number of days between 1 and 180.
+	ErrInvalidReminderInterval = stderrors.New("reminder interval must be whole days between 1 and 180")
+
+	// ErrNoReminders indicates a send-reminder request targets no recipients.
+	ErrNoReminders = stderrors.New("at least one reminder is required")
+
+	// ErrDocumentHasNoTemplate indicates a document was not created from a template.
+	ErrDocumentHasNoTemplate = stderrors.New("document was not created from a template")
+
+	// ErrContentLibraryItemFailed indicates PandaDoc could not process a content library item.
+	ErrContentLibraryItemFailed = stderrors.New("content library item processing failed")
+
+	// ErrIncompleteLinkedObject indicates a linked object reference is missing its provider, entity type, or entity ID.
+	ErrIncompleteLinkedObject = stderrors.New("linked object requires provider, entity type, and entity id")
+
+	// ErrCatalogItemHasNoPrice indicates a product catalog item has no default price configuration.
+	ErrCatalogItemHasNoPrice = stderrors.New("catalog item has no default price configuration")
+
+	// ErrQuoteCurrencyMismatch indicates catalog items with different currencies were added to one quote.
+	ErrQuoteCurrencyMismatch = stderrors.New("quote items use different currencies")
+
+	// ErrNoQuoteLines indicates a quote update was built without line items.
+	ErrNoQuoteLines = stderrors.New("at least one quote line is required")
+
+	// ErrInvalidQuoteQuantity indicates a quote line with a non-positive quantity.
+	ErrInvalidQuoteQuantity = stderrors.New("quote line quantity must be positive")
+
+	// ErrNotarizationNotCompleted indicates a notarization request finished without being completed.
+	ErrNotarizationNotCompleted = stderrors.New("notarization request was not completed")
+
+	// ErrDocxExportFailed indicates a DOCX export task failed or produced no file.
+	ErrDocxExportFailed = stderrors.New("docx export failed")
+
+	// ErrDocxExportMultipleFiles indicates a DOCX export produced one file per section.
+	ErrDocxExportMultipleFiles = stderrors.New("docx export produced multiple files")
+
+	// ErrNoDSVNamedItems indicates a DSV named items request without items.
+	ErrNoDSVNamedItems = stderrors.New("at least one DSV named item is required")
+
+	// ErrUnknownOperation indicates an operation ID that is not in the SDK's API manifest.
+	ErrUnknownOperation = stderrors.New("unknown operation ID")
+
+	// ErrUploadNotRewindable indicates an upload whose file reader cannot be read again.
+	ErrUploadNotRewindable = stderrors.New("upload file reader cannot be rewound")
 )
 
 // APIError represents a non-2xx response from PandaDoc.
@@ -88,3 +169,31 @@ func IsNotFound(err error) bool {
 	}
 	return apiErr.StatusCode == http.StatusNotFound
 }
+
+// IsValidationError returns true if err is a 400 API error carrying
+// field-level validation details.
+func IsValidationError(err error) bool {
+	var apiErr *APIError
+	if !stderrors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.StatusCode != http.StatusBadRequest {
+		return false
+	}
+	switch apiErr.Details.(type) {
+	case map[string]any, []any:
+		return true
+	}
+	return false
+}
+
+// IsInvalidDocumentState returns true if err is a 409 conflict, which PandaDoc
+// returns when the document's current status does not allow the requested
+// change.
+//
+// Some endpoints report state problems as plain 400 or 403 request errors;
+// those are indistinguishable from other request errors and are not matched.
+func IsInvalidDocumentState(err error) bool {
+	var apiErr *APIError
+	return stderrors.As(err, &apiErr) && apiErr.StatusCode == http.StatusConflict
+}
@@ -50,3 +50,34 @@ func TestAPIErrorHelpers(t *testing.T) {
 		t.Fatalf("expected nil receiver error string to be empty")
 	}
 }
+
+func TestValidationAndDocumentStateHelpers(t *testing.T) {
+	t.Parallel()
+
+	validation := &APIError{StatusCode: http.StatusBadRequest, Code: "request_error", Message: "Bad Request", Details: map[string]any{"email": []any{"Enter a valid email address."}}}
+	stateMsg := &APIError{StatusCode: http.StatusBadRequest, Code: "request_error", Message: "Document is not in draft status"}
+	badRequest := &APIError{StatusCode: http.StatusBadRequest, Code: "request_error", Message: "Invalid JSON payload"}
+	forbidden := &APIError{StatusCode: http.StatusForbidden, Code: "request_error", Message: "Access to this resource is forbidden"}
+	conflict := &APIError{StatusCode: http.StatusConflict, Message: "Conflict"}
+	permission := &APIError{StatusCode: http.StatusForbidden, Code: "permission_error", Message: "You do not have permission to change the document status."}
+	untyped := &APIError{StatusCode: http.StatusBadRequest, Message: "Document is not in draft status"}
+
+	if !IsValidationError(validation) || IsValidationError(stateMsg) || IsValidationError(conflict) || IsValidationError(errTestDummy) {
+		t.Fatalf("validation helper mismatch")
+	}
+	if !IsValidationError(&APIError{StatusCode: http.StatusBadRequest, Details: []any{"x"}}) {
+		t.Fatalf("expected list details to count as validation error")
+	}
+	if !IsInvalidDocumentState(conflict) {
+		t.Fatalf("document state helper mismatch")
+	}
+	if IsInvalidDocumentState(validation) || IsInvalidDocumentState(permission) || IsInvalidDocumentState(untyped) || IsInvalidDocumentState(errTestDummy) {
+		t.Fatalf("expected non-state errors to be rejected")
+	}
+	if IsInvalidDocumentState(badRequest) || IsInvalidDocumentState(forbidden) || IsInvalidDocumentState(stateMsg) {
+		t.Fatalf("expected ordinary 400 and 403 request errors to be rejected")
+	}
+	if IsInvalidDocumentState(&APIError{StatusCode: http.StatusNotFound, Code: "request_error"}) {
+		t.Fatalf("expected 404 not to be a document state error")
+	}
+}
@@ -0,0 +1,181 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	documentFoldersPath = "/public/v1/documents/folders"
+	templateFoldersPath = "/public/v1/templates/folders"
+
+	// folderPageSize is the page size used when walking folder listings.
+	folderPageSize = 100
+)
+
+// foldersService implements FoldersService.
+type foldersService struct {
+	client *Client
+}
+
+// ListDocumentFolders lists document folders.
+func (s *foldersService) ListDocumentFolders(ctx context.Context, opts *ListFoldersOptions) (*FolderListResponse, error) {
+	return s.list(ctx, documentFoldersPath, opts)
+}
+
+// CreateDocumentFolder creates a document folder.
+func (s *foldersService) CreateDocumentFolder(ctx context.Context, reqBody *CreateFolderRequest) (*Folder, error) {
+	return s.create(ctx, documentFoldersPath, reqBody)
+}
+
+// RenameDocumentFolder renames a document folder.
+func (s *foldersService) RenameDocumentFolder(ctx context.Context, id string, reqBody *RenameFolderRequest) (*Folder, error) {
+	return s.rename(ctx, documentFoldersPath, id, reqBody)
+}
+
+// ResolveDocumentFolderPath resolves a slash-separated document folder path to a folder UUID.
+func (s *foldersService) ResolveDocumentFolderPath(ctx context.Context, path string, createMissing bool) (string, error) {
+	return s.resolvePath(ctx, documentFoldersPath, path, createMissing)
+}
+
+// ListTemplateFolders lists template folders.
+func (s *foldersService) ListTemplateFolders(ctx context.Context, opts *ListFoldersOptions) (*FolderListResponse, error) {
+	return s.list(ctx, templateFoldersPath, opts)
+}
+
+// CreateTemplateFolder creates a template folder.
+func (s *foldersService) CreateTemplateFolder(ctx context.Context, reqBody *CreateFolderRequest) (*Folder, error) {
+	return s.create(ctx, templateFoldersPath, reqBody)
+}
+
+// RenameTemplateFolder renames a template folder.
+func (s *foldersService) RenameTemplateFolder(ctx context.Context, id string, reqBody *RenameFolderRequest) (*Folder, error) {
+	return s.rename(ctx, templateFoldersPath, id, reqBody)
+}
+
+// ResolveTemplateFolderPath resolves a slash-separated template folder path to a folder UUID.
+func (s *foldersService) ResolveTemplateFolderPath(ctx context.Context, path string, createMissing bool) (string, error) {
+	return s.resolvePath(ctx, templateFoldersPath, path, createMissing)
+}
+
+func (s *foldersService) list(ctx context.Context, basePath string, opts *ListFoldersOptions) (*FolderListResponse, error) {
+	query := url.Values{}
+	if opts != nil {
+		setIfNotEmpty(query, "parent_uuid", opts.ParentUUID)
+		setIfPositive(query, "count", opts.Count)
+		setIfPositive(query, "page", opts.Page)
+	}
+
+	var out FolderListResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        basePath,
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (s *foldersService) create(ctx context.Context, basePath string, reqBody *CreateFolderRequest) (*Folder, error) {
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out Folder
+	err := s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPost,
+		path:           basePath,
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (s *foldersService) rename(ctx context.Context, basePath, id string, reqBody *RenameFolderRequest) (*Folder, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out Folder
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPut,
+		path:        basePath + "/" + escapedID,
+		requireAuth: true,
+		jsonBody:    reqBody,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// resolvePath walks path one segment at a time, matching folder names exactly
+// under the previously resolved parent.
+func (s *foldersService) resolvePath(ctx context.Context, basePath, path string, createMissing bool) (string, error) {
+	segments := splitFolderPath(path)
+	if len(segments) == 0 {
+		return "", ErrEmptyFolderPath
+	}
+
+	parentUUID := ""
+	for i, name := range segments {
+		folder, found, err := s.findChild(ctx, basePath, parentUUID, name)
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			if !createMissing {
+				return "", fmt.Errorf("%s: %w", strings.Join(segments[:i+1], "/"), ErrFolderNotFound)
+			}
+			folder, err = s.create(ctx, basePath, &CreateFolderRequest{Name: name, ParentUUID: parentUUID})
+			if err != nil {
+				return "", fmt.Errorf("create folder %q: %w", strings.Join(segments[:i+1], "/"), err)
+			}
+		}
+		parentUUID = folder.UUID
+	}
+
+	return parentUUID, nil
+}
+
+func (s *foldersService) findChild(ctx context.Context, basePath, parentUUID, name string) (*Folder, bool, error) {
+	for page := 1; ; page++ {
+		resp, err := s.list(ctx, basePath, &ListFoldersOptions{ParentUUID: parentUUID, Count: folderPageSize, Page: page})
+		if err != nil {
+			return nil, false, err
+		}
+		for i := range resp.Results {
+			if resp.Results[i].Name == name {
+				return &resp.Results[i], true, nil
+			}
+		}
+		if len(resp.Results) < folderPageSize {
+			return nil, false, nil
+		}
+	}
+}
+
+func splitFolderPath(path string) []string {
+	parts := strings.Split(path, "/")
+	segments := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p = strings.TrimSpace(p); p != "" {
+			segments = append(segments, p)
+		}
+	}
+	return segments
+}
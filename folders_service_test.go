@@ -0,0 +1,208 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestFoldersService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && (r.URL.Path == "/public/v1/documents/folders" || r.URL.Path == "/public/v1/templates/folders"):
+			q := r.URL.Query()
+			assertQueryEq(t, q, "parent_uuid", "p1")
+			assertQueryEq(t, q, "count", "10")
+			assertQueryEq(t, q, "page", "2")
+			_, _ = io.WriteString(w, `{"results":[{"uuid":"f1","name":"Sales","has_folders":true}]}`)
+		case r.Method == http.MethodPost && (r.URL.Path == "/public/v1/documents/folders" || r.URL.Path == "/public/v1/templates/folders"):
+			var payload CreateFolderRequest
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil || payload.Name != "New" || payload.ParentUUID != "p1" {
+				t.Fatalf("unexpected create payload: %+v (%v)", payload, err)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"uuid":"f2","name":"New"}`)
+		case r.Method == http.MethodPut && (r.URL.Path == "/public/v1/documents/folders/f2" || r.URL.Path == "/public/v1/templates/folders/f2"):
+			_, _ = io.WriteString(w, `{"uuid":"f2","name":"Renamed"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	opts := &ListFoldersOptions{ParentUUID: "p1", Count: 10, Page: 2}
+	create := &CreateFolderRequest{Name: "New", ParentUUID: "p1"}
+	rename := &RenameFolderRequest{Name: "Renamed"}
+
+	docFolders, err := client.Folders().ListDocumentFolders(context.Background(), opts)
+	if err != nil || len(docFolders.Results) != 1 || !docFolders.Results[0].HasFolders {
+		t.Fatalf("ListDocumentFolders failed: %v %+v", err, docFolders)
+	}
+	tplFolders, err := client.Folders().ListTemplateFolders(context.Background(), opts)
+	if err != nil || len(tplFolders.Results) != 1 {
+		t.Fatalf("ListTemplateFolders failed: %v %+v", err, tplFolders)
+	}
+
+	if f, err := client.Folders().CreateDocumentFolder(context.Background(), create); err != nil || f.UUID != "f2" {
+		t.Fatalf("CreateDocumentFolder failed: %v %+v", err, f)
+	}
+	if f, err := client.Folders().CreateTemplateFolder(context.Background(), create); err != nil || f.UUID != "f2" {
+		t.Fatalf("CreateTemplateFolder failed: %v %+v", err, f)
+	}
+
+	if f, err := client.Folders().RenameDocumentFolder(context.Background(), "f2", rename); err != nil || f.Name != "Renamed" {
+		t.Fatalf("RenameDocumentFolder failed: %v %+v", err, f)
+	}
+	if f, err := client.Folders().RenameTemplateFolder(context.Background(), "f2", rename); err != nil || f.Name != "Renamed" {
+		t.Fatalf("RenameTemplateFolder failed: %v %+v", err, f)
+	}
+}
+
+func TestFoldersService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	if _, err := client.Folders().CreateDocumentFolder(context.Background(), nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected ErrNilRequest, got %v", err)
+	}
+	if _, err := client.Folders().RenameTemplateFolder(context.Background(), "f1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected ErrNilRequest, got %v", err)
+	}
+	if _, err := client.Folders().RenameDocumentFolder(context.Background(), "", &RenameFolderRequest{Name: "x"}); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected ErrEmptyPathParameter, got %v", err)
+	}
+	if _, err := client.Folders().ResolveDocumentFolderPath(context.Background(), " / /", true); !errors.Is(err, ErrEmptyFolderPath) {
+		t.Fatalf("expected ErrEmptyFolderPath, got %v", err)
+	}
+}
+
+// fakeFolderTree is a minimal in-memory folder API used by resolver tests.
+type fakeFolderTree struct {
+	mu      sync.Mutex
+	folders []fakeFolder
+	created []string
+}
+
+type fakeFolder struct {
+	uuid, parent, name string
+}
+
+func (f *fakeFolderTree) handler(t *testing.T) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		f.mu.Lock()
+		defer f.mu.Unlock()
+
+		if r.URL.Path != "/public/v1/documents/folders" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			q := r.URL.Query()
+			count, _ := strconv.Atoi(q.Get("count"))
+			page, _ := strconv.Atoi(q.Get("page"))
+			var children []Folder
+			for _, ff := range f.folders {
+				if ff.parent == q.Get("parent_uuid") {
+					children = append(children, Folder{UUID: ff.uuid, Name: ff.name})
+				}
+			}
+			start := (page - 1) * count
+			end := start + count
+			if start > len(children) {
+				start = len(children)
+			}
+			if end > len(children) {
+				end = len(children)
+			}
+			_ = json.NewEncoder(w).Encode(FolderListResponse{Results: children[start:end]})
+		case http.MethodPost:
+			var req CreateFolderRequest
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			uuid := fmt.Sprintf("new-%d", len(f.created)+1)
+			f.folders = append(f.folders, fakeFolder{uuid: uuid, parent: req.ParentUUID, name: req.Name})
+			f.created = append(f.created, req.Name)
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(Folder{UUID: uuid, Name: req.Name})
+		default:
+			t.Fatalf("unexpected method %s", r.Method)
+		}
+	}
+}
+
+func TestFoldersService_ResolveDocumentFolderPath(t *testing.T) {
+	t.Parallel()
+
+	tree := &fakeFolderTree{folders: []fakeFolder{
+		{uuid: "sales", name: "Sales"},
+		{uuid: "y2026", parent: "sales", name: "2026"},
+	}}
+	// Push "Q3" onto the second page of its parent listing.
+	for i := 0; i < folderPageSize; i++ {
+		tree.folders = append(tree.folders, fakeFolder{uuid: "filler-" + strconv.Itoa(i), parent: "y2026", name: "Filler " + strconv.Itoa(i)})
+	}
+	tree.folders = append(tree.folders, fakeFolder{uuid: "q3", parent: "y2026", name: "Q3"})
+
+	client := newTestClient(t, tree.handler(t))
+
+	id, err := client.Folders().ResolveDocumentFolderPath(context.Background(), "/Sales/ 2026 /Q3/", false)
+	if err != nil || id != "q3" {
+		t.Fatalf("resolve existing path: id=%q err=%v", id, err)
+	}
+
+	if _, err = client.Folders().ResolveDocumentFolderPath(context.Background(), "Sales/2027/Q1", false); !errors.Is(err, ErrFolderNotFound) {
+		t.Fatalf("expected ErrFolderNotFound, got %v", err)
+	}
+	if len(tree.created) != 0 {
+		t.Fatalf("expected no folders to be created, got %v", tree.created)
+	}
+
+	id, err = client.Folders().ResolveDocumentFolderPath(context.Background(), "Sales/2027/Q1", true)
+	if err != nil {
+		t.Fatalf("resolve with create failed: %v", err)
+	}
+	if len(tree.created) != 2 || tree.created[0] != "2027" || tree.created[1] != "Q1" || id != "new-2" {
+		t.Fatalf("unexpected created folders: %v id=%s", tree.created, id)
+	}
+
+	again, err := client.Folders().ResolveDocumentFolderPath(context.Background(), "Sales/2027/Q1", true)
+	if err != nil || again != id || len(tree.created) != 2 {
+		t.Fatalf("expected idempotent resolve: id=%s err=%v created=%v", again, err, tree.created)
+	}
+}
+
+func TestFoldersService_ResolvePathErrors(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			_, _ = io.WriteString(w, `{"results":[]}`)
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = io.WriteString(w, `{"detail":"no access"}`)
+	})
+
+	if _, err := client.Folders().ResolveTemplateFolderPath(context.Background(), "A/B", true); !IsForbidden(err) {
+		t.Fatalf("expected forbidden create error, got %v", err)
+	}
+
+	listFails := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	if _, err := listFails.Folders().ResolveTemplateFolderPath(context.Background(), "A", false); err == nil {
+		t.Fatalf("expected list error")
+	}
+}
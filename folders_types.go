@@ -0,0 +1,33 @@
+package pandadoc
+
+// ListFoldersOptions configures document/template folder listing.
+type ListFoldersOptions struct {
+	ParentUUID string
+	Count      int
+	Page       int
+}
+
+// Folder represents a document or template folder.
+type Folder struct {
+	UUID        string `json:"uuid,omitempty"`
+	Name        string `json:"name,omitempty"`
+	DateCreated string `json:"date_created,omitempty"`
+	HasFolders  bool   `json:"has_folders,omitempty"`
+	HasItems    bool   `json:"has_items,omitempty"`
+}
+
+// FolderListResponse is returned by folder list endpoints.
+type FolderListResponse struct {
+	Results []Folder `json:"results"`
+}
+
+// CreateFolderRequest creates a folder, optionally nested under a parent folder.
+type CreateFolderRequest struct {
+	Name       string `json:"name"`
+	ParentUUID string `json:"parent_uuid,omitempty"`
+}
+
+// RenameFolderRequest renames a folder.
+type RenameFolderRequest struct {
+	Name string `json:"name"`
+}
@@ -0,0 +1,72 @@
+package pandadoc
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// formsService implements FormsService.
+type formsService struct {
+	client *Client
+}
+
+// List lists forms.
+func (s *formsService) List(ctx context.Context, opts *ListFormsOptions) (*FormListResponse, error) {
+	query := url.Values{}
+	if opts != nil {
+		setIfPositive(query, "count", opts.Count)
+		setIfPositive(query, "page", opts.Page)
+		for _, status := range opts.Statuses {
+			query.Add("status", string(status))
+		}
+		setIfNotEmpty(query, "order_by", string(opts.OrderBy))
+		setIfNotNil(query, "asc", opts.Asc)
+		setIfNotEmpty(query, "name", opts.Name)
+	}
+
+	var out FormListResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/forms",
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// All iterates over every form matching opts, fetching pages as needed.
+//
+// opts.Page sets the first page. Iteration stops at the first error, which is yielded once.
+func (s *formsService) All(ctx context.Context, opts *ListFormsOptions) iter.Seq2[Form, error] {
+	page := ListFormsOptions{}
+	if opts != nil {
+		page = *opts
+	}
+	if page.Page <= 0 {
+		page.Page = 1
+	}
+
+	return func(yield func(Form, error) bool) {
+		for {
+			resp, err := s.List(ctx, &page)
+			if err != nil {
+				yield(Form{}, err)
+				return
+			}
+			for _, form := range resp.Results {
+				if !yield(form, nil) {
+					return
+				}
+			}
+			if !resp.HasNextPage || len(resp.Results) == 0 {
+				return
+			}
+			page.Page++
+		}
+	}
+}
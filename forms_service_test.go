@@ -0,0 +1,105 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestFormsService_List(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/public/v1/forms" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		q := r.URL.Query()
+		assertQueryEq(t, q, "count", "10")
+		assertQueryEq(t, q, "page", "2")
+		assertQueryEq(t, q, "order_by", "modified_date")
+		assertQueryEq(t, q, "asc", "false")
+		assertQueryEq(t, q, "name", "Lead")
+		if got := q["status"]; len(got) != 2 || got[0] != "draft" || got[1] != "active" {
+			t.Fatalf("unexpected statuses: %v", got)
+		}
+		_, _ = io.WriteString(w, `{"has_next_page":true,"results":[{"id":"f1","name":"Lead form","status":"ACTIVE","date_created":"2026-01-01T00:00:00Z"}]}`)
+	})
+
+	resp, err := client.Forms().List(context.Background(), &ListFormsOptions{
+		Count:    10,
+		Page:     2,
+		Statuses: []FormStatus{FormStatusDraft, FormStatusActive},
+		OrderBy:  FormOrderByModifiedDate,
+		Asc:      ptrBool(false),
+		Name:     "Lead",
+	})
+	if err != nil || !resp.HasNextPage || resp.Results[0].Status != FormStatusActive {
+		t.Fatalf("List failed: %v %+v", err, resp)
+	}
+}
+
+func TestFormStatus_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	var form Form
+	if err := json.Unmarshal([]byte(`{"id":"f1","status":"DRAFT"}`), &form); err != nil || form.Status != FormStatusDraft {
+		t.Fatalf("expected uppercase status to decode as draft: %v %+v", err, form)
+	}
+	if err := json.Unmarshal([]byte(`{"status":1}`), &form); err == nil {
+		t.Fatalf("expected decode error for non-string status")
+	}
+}
+
+func TestFormsService_All(t *testing.T) {
+	t.Parallel()
+
+	var pages []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page")
+		pages = append(pages, page)
+		switch page {
+		case "1":
+			_, _ = io.WriteString(w, `{"has_next_page":true,"results":[{"id":"f1"},{"id":"f2"}]}`)
+		case "2":
+			_, _ = io.WriteString(w, `{"has_next_page":false,"results":[{"id":"f3"}]}`)
+		default:
+			t.Fatalf("unexpected page %s", page)
+		}
+	})
+
+	var ids []string
+	for form, err := range client.Forms().All(context.Background(), nil) {
+		if err != nil {
+			t.Fatalf("All failed: %v", err)
+		}
+		ids = append(ids, form.ID)
+	}
+	if fmt.Sprint(ids) != "[f1 f2 f3]" || fmt.Sprint(pages) != "[1 2]" {
+		t.Fatalf("unexpected iteration: ids=%v pages=%v", ids, pages)
+	}
+
+	for form := range client.Forms().All(context.Background(), &ListFormsOptions{Page: 2}) {
+		if form.ID != "f3" {
+			t.Fatalf("unexpected form: %+v", form)
+		}
+		break
+	}
+
+	errClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = r
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	calls := 0
+	for _, err := range errClient.Forms().All(context.Background(), nil) {
+		calls++
+		if !IsUnauthorized(err) {
+			t.Fatalf("expected unauthorized error, got %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected error to be yielded once, got %d", calls)
+	}
+}
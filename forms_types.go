@@ -0,0 +1,77 @@
+package pandadoc
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// FormStatus is the publication status of a form.
+//
+// List filters take lowercase statuses, while PandaDoc returns them in upper
+// case (such as "DRAFT"); decoded statuses are lowercased to match the constants.
+type FormStatus string
+
+// Supported form statuses.
+const (
+	// FormStatusDraft represents a form that has not been published.
+	FormStatusDraft FormStatus = "draft"
+	// FormStatusActive represents a published form that accepts responses.
+	FormStatusActive FormStatus = "active"
+	// FormStatusDisabled represents a form that no longer accepts responses.
+	FormStatusDisabled FormStatus = "disabled"
+)
+
+// UnmarshalJSON decodes a status in any case.
+func (s *FormStatus) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("decode form status: %w", err)
+	}
+	*s = FormStatus(strings.ToLower(value))
+	return nil
+}
+
+// FormOrderBy controls form list ordering.
+type FormOrderBy string
+
+// Form order by constants.
+const (
+	// FormOrderByName orders forms by name.
+	FormOrderByName FormOrderBy = "name"
+	// FormOrderByResponses orders forms by response count.
+	FormOrderByResponses FormOrderBy = "responses"
+	// FormOrderByStatus orders forms by status.
+	FormOrderByStatus FormOrderBy = "status"
+	// FormOrderByCreatedDate orders forms by creation date.
+	FormOrderByCreatedDate FormOrderBy = "created_date"
+	// FormOrderByModifiedDate orders forms by last modification date.
+	FormOrderByModifiedDate FormOrderBy = "modified_date"
+)
+
+// ListFormsOptions controls list forms query params.
+type ListFormsOptions struct {
+	Count    int
+	Page     int
+	Statuses []FormStatus
+	OrderBy  FormOrderBy
+	Asc      *bool
+	Name     string
+}
+
+// Form models a PandaDoc form.
+//
+// Use ID as ListDocumentsOptions.FormID to list documents submitted through the form.
+type Form struct {
+	ID           string     `json:"id"`
+	Name         string     `json:"name,omitempty"`
+	Status       FormStatus `json:"status,omitempty"`
+	DateCreated  string     `json:"date_created,omitempty"`
+	DateModified string     `json:"date_modified,omitempty"`
+}
+
+// FormListResponse models list forms response.
+type FormListResponse struct {
+	Results     []Form `json:"results"`
+	HasNextPage bool   `json:"has_next_page"`
+}
@@ -1,6 +1,10 @@
 package pandadoc
 
-import "context"
+import (
+	"context"
+	"iter"
+	"time"
+)
 
 // DocumentsService handles document-related PandaDoc API calls.
 type DocumentsService interface {
@@ -55,3 +59,185 @@ type WebhookEventsService interface {
 	List(ctx context.Context, opts *ListWebhookEventsOptions) (*WebhookEventListResponse, error)
 	Get(ctx context.Context, id string) (*WebhookEventDetailsResponse, error)
 }
+
+// ContactsService handles contact endpoints.
+type ContactsService interface {
+	List(ctx context.Context, opts *ListContactsOptions) (*ContactListResponse, error)
+	Create(ctx context.Context, reqBody *ContactRequest) (*Contact, error)
+	Get(ctx context.Context, id string) (*Contact, error)
+	Update(ctx context.Context, id string, reqBody *ContactRequest) (*Contact, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// TemplatesService handles template endpoints.
+type TemplatesService interface {
+	List(ctx context.Context, opts *ListTemplatesOptions) (*TemplateListResponse, error)
+	Create(ctx context.Context, reqBody *CreateTemplateRequest) (*TemplateCreateResponse, error)
+	CreateFromUpload(ctx context.Context, reqBody *CreateTemplateFromUploadRequest) (*TemplateCreateResponse, error)
+	Status(ctx context.Context, id string) (*TemplateStatusResponse, error)
+	Details(ctx context.Context, id string) (*TemplateDetailsResponse, error)
+	Update(ctx context.Context, id string, reqBody *UpdateTemplateRequest) error
+	Delete(ctx context.Context, id string) error
+	CreateEditingSession(ctx context.Context, id string, reqBody *CreateTemplateEditingSessionRequest) (*CreateTemplateEditingSessionResponse, error)
+}
+
+// FoldersService handles document and template folder endpoints.
+type FoldersService interface {
+	ListDocumentFolders(ctx context.Context, opts *ListFoldersOptions) (*FolderListResponse, error)
+	CreateDocumentFolder(ctx context.Context, reqBody *CreateFolderRequest) (*Folder, error)
+	RenameDocumentFolder(ctx context.Context, id string, reqBody *RenameFolderRequest) (*Folder, error)
+	ResolveDocumentFolderPath(ctx context.Context, path string, createMissing bool) (string, error)
+	ListTemplateFolders(ctx context.Context, opts *ListFoldersOptions) (*FolderListResponse, error)
+	CreateTemplateFolder(ctx context.Context, reqBody *CreateFolderRequest) (*Folder, error)
+	RenameTemplateFolder(ctx context.Context, id string, reqBody *RenameFolderRequest) (*Folder, error)
+	ResolveTemplateFolderPath(ctx context.Context, path string, createMissing bool) (string, error)
+}
+
+// DocumentRecipientsService handles document recipient endpoints.
+type DocumentRecipientsService interface {
+	Add(ctx context.Context, documentID string, reqBody *AddDocumentRecipientRequest) (*DocumentRecipient, error)
+	Edit(ctx context.Context, documentID, recipientID string, reqBody *EditDocumentRecipientRequest) error
+	Delete(ctx context.Context, documentID, recipientID string) error
+	Reassign(ctx context.Context, documentID, recipientID string, reqBody *ReassignDocumentRecipientRequest) (*DocumentRecipient, error)
+}
+
+// DocumentAttachmentsService handles document attachment endpoints.
+type DocumentAttachmentsService interface {
+	List(ctx context.Context, documentID string) ([]DocumentAttachment, error)
+	Create(ctx context.Context, documentID string, reqBody *CreateDocumentAttachmentRequest) (*DocumentAttachment, error)
+	Get(ctx context.Context, documentID, attachmentID string) (*DocumentAttachment, error)
+	Delete(ctx context.Context, documentID, attachmentID string) error
+	Download(ctx context.Context, documentID, attachmentID string) (*DownloadResponse, error)
+}
+
+// DocumentFieldsService handles document field endpoints.
+type DocumentFieldsService interface {
+	List(ctx context.Context, documentID string) (*DocumentFieldsResponse, error)
+	Create(ctx context.Context, documentID string, reqBody *CreateDocumentFieldsRequest) (*DocumentFieldsResponse, error)
+}
+
+// DocumentSectionsService handles document section (bundle) endpoints.
+type DocumentSectionsService interface {
+	List(ctx context.Context, documentID string) (*DocumentSectionListResponse, error)
+	Upload(ctx context.Context, documentID string, reqBody DocumentSectionUploadRequest, opts *UploadSectionOptions) (*DocumentSectionUpload, error)
+	UploadFromFile(ctx context.Context, documentID string, reqBody *UploadDocumentSectionFromFileRequest) (*DocumentSectionUpload, error)
+	UploadDetails(ctx context.Context, documentID, uploadID string) (*DocumentSectionUpload, error)
+	WaitForUpload(ctx context.Context, documentID, uploadID string, opts *PollOptions) (*DocumentSectionUpload, error)
+	Get(ctx context.Context, documentID, sectionID string) (*DocumentSection, error)
+	Delete(ctx context.Context, documentID, sectionID string) error
+}
+
+// DocumentRemindersService handles document reminder endpoints.
+type DocumentRemindersService interface {
+	GetAutoReminders(ctx context.Context, documentID string) (*AutoReminderSettings, error)
+	UpdateAutoReminders(ctx context.Context, documentID string, settings *AutoReminderSettings) (*AutoReminderSettings, error)
+	AutoReminderStatus(ctx context.Context, documentID string) (*AutoReminderStatusResponse, error)
+	SendReminder(ctx context.Context, documentID string, reqBody *SendReminderRequest) (*SendReminderResponse, error)
+}
+
+// SettingsService handles v2 document and template settings endpoints.
+type SettingsService interface {
+	GetDocument(ctx context.Context, documentID string) (*DocumentSettings, error)
+	UpdateDocument(ctx context.Context, documentID string, reqBody *UpdateDocumentSettingsRequest) (*DocumentSettings, error)
+	GetTemplate(ctx context.Context, templateID string) (*TemplateSettings, error)
+	UpdateTemplate(ctx context.Context, templateID string, reqBody *UpdateTemplateSettingsRequest) (*TemplateSettings, error)
+	CompareDocumentWithTemplate(ctx context.Context, documentID string) ([]SettingDifference, error)
+}
+
+// ContentLibraryService handles content library item endpoints.
+type ContentLibraryService interface {
+	List(ctx context.Context, opts *ListContentLibraryItemsOptions) (*ContentLibraryItemListResponse, error)
+	Create(ctx context.Context, reqBody *CreateContentLibraryItemRequest) (*ContentLibraryItemStatusResponse, error)
+	CreateFromUpload(ctx context.Context, reqBody *CreateContentLibraryItemFromUploadRequest) (*ContentLibraryItemStatusResponse, error)
+	Status(ctx context.Context, id string) (*ContentLibraryItemStatusResponse, error)
+	Details(ctx context.Context, id string) (*ContentLibraryItemDetailsResponse, error)
+	WaitForProcessing(ctx context.Context, id string, opts *PollOptions) (*ContentLibraryItemStatusResponse, error)
+}
+
+// LinkedObjectsService handles CRM linked-object endpoints.
+type LinkedObjectsService interface {
+	List(ctx context.Context, documentID string) (*LinkedObjectListResponse, error)
+	Create(ctx context.Context, documentID string, ref LinkedObjectRef) (*LinkedObject, error)
+	Delete(ctx context.Context, documentID, linkedObjectID string) error
+	ListDocuments(ctx context.Context, ref LinkedObjectRef, opts *ListDocumentsByLinkedObjectOptions) ([]DocumentSummary, error)
+}
+
+// QuotesService handles document quote endpoints.
+type QuotesService interface {
+	Update(ctx context.Context, documentID, quoteID string, reqBody *UpdateQuoteRequest) (*Quote, error)
+}
+
+// MembersService handles workspace member endpoints.
+type MembersService interface {
+	List(ctx context.Context) (*MemberListResponse, error)
+	Current(ctx context.Context) (*Member, error)
+	Get(ctx context.Context, id string) (*Member, error)
+	CreateToken(ctx context.Context, memberID string, reqBody *CreateMemberTokenRequest) (*MemberToken, error)
+}
+
+// WorkspacesService handles organization workspace endpoints.
+type WorkspacesService interface {
+	List(ctx context.Context, opts *ListWorkspacesOptions) (*WorkspaceListResponse, error)
+	Create(ctx context.Context, reqBody *CreateWorkspaceRequest) (*Workspace, error)
+	Deactivate(ctx context.Context, workspaceID string) error
+	AddMember(ctx context.Context, workspaceID string, reqBody *AddWorkspaceMemberRequest, notify *MemberNotificationOptions) (*WorkspaceMember, error)
+	RemoveMember(ctx context.Context, workspaceID, memberID string) error
+	CreateAPIKey(ctx context.Context, workspaceID string, reqBody *CreateAPIKeyRequest) (*APIKey, error)
+}
+
+// UsersService handles organization user endpoints.
+type UsersService interface {
+	List(ctx context.Context, opts *ListUsersOptions) (*UserListResponse, error)
+	Create(ctx context.Context, reqBody *CreateUserRequest, notify *MemberNotificationOptions) (*CreateUserResponse, error)
+}
+
+// APILogsService handles v1 and v2 API log endpoints.
+type APILogsService interface {
+	List(ctx context.Context, opts *ListAPILogsOptions) (*APILogListResponse, error)
+	Get(ctx context.Context, id string) (*APILogDetails, error)
+	All(ctx context.Context, opts *ListAPILogsOptions) iter.Seq2[APILogEntry, error]
+	ListV2(ctx context.Context, opts *ListAPILogsOptions) (*APILogListResponse, error)
+	GetV2(ctx context.Context, id string) (*APILogDetails, error)
+	AllV2(ctx context.Context, opts *ListAPILogsOptions) iter.Seq2[APILogEntry, error]
+}
+
+// NotaryService handles v2 notary and notarization request endpoints.
+type NotaryService interface {
+	ListNotaries(ctx context.Context, opts *ListNotariesOptions) (*NotaryListResponse, error)
+	CreateRequest(ctx context.Context, reqBody *CreateNotarizationRequest) (*NotarizationRequest, error)
+	GetRequest(ctx context.Context, id string) (*NotarizationRequest, error)
+	DeleteRequest(ctx context.Context, id string) error
+	WaitForRequest(ctx context.Context, id string, opts *PollOptions) (*NotarizationRequest, error)
+}
+
+// FormsService handles form endpoints.
+type FormsService interface {
+	List(ctx context.Context, opts *ListFormsOptions) (*FormListResponse, error)
+	All(ctx context.Context, opts *ListFormsOptions) iter.Seq2[Form, error]
+}
+
+// SMSOptOutsService handles SMS opt-out endpoints.
+type SMSOptOutsService interface {
+	List(ctx context.Context, opts *ListSMSOptOutsOptions) (*SMSOptOutListResponse, error)
+	All(ctx context.Context, from, to time.Time, window time.Duration) iter.Seq2[SMSOptOutChange, error]
+}
+
+// DocumentStructureService handles Document Structure View (DSV) endpoints.
+type DocumentStructureService interface {
+	AddNamedItems(ctx context.Context, documentID string, reqBody *AddDSVNamedItemsRequest) (*AddDSVNamedItemsResponse, error)
+}
+
+// DocumentAuditTrailService handles document audit trail endpoints.
+type DocumentAuditTrailService interface {
+	List(ctx context.Context, documentID string, opts *AuditTrailOptions) (*AuditTrailResponse, error)
+	All(ctx context.Context, documentID string, opts *AuditTrailOptions) iter.Seq2[AuditTrailEvent, error]
+}
+
+// DocumentDocxExportService handles beta DOCX export endpoints.
+type DocumentDocxExportService interface {
+	Create(ctx context.Context, id string) (*DocxExportTask, error)
+	Get(ctx context.Context, id, taskID string) (*DocxExportTask, error)
+	WaitForExport(ctx context.Context, id, taskID string, opts *PollOptions) (*DocxExportTask, error)
+	DownloadItem(ctx context.Context, item DocxExportItem) (*DownloadResponse, error)
+	Export(ctx context.Context, id string, opts *PollOptions) (*DownloadResponse, error)
+}
@@ -75,6 +75,131 @@ var requiredOperations = []operation{ //nolint:gochecknoglobals // needed for te
 	{Method: "PATCH", Path: "/public/v1/webhook-subscriptions/{id}/shared-key"},
 	{Method: "GET", Path: "/public/v1/webhook-events"},
 	{Method: "GET", Path: "/public/v1/webhook-events/{id}"},
+
+	// Contacts (5)
+	{Method: "GET", Path: "/public/v1/contacts"},
+	{Method: "POST", Path: "/public/v1/contacts"},
+	{Method: "GET", Path: "/public/v1/contacts/{id}"},
+	{Method: "PATCH", Path: "/public/v1/contacts/{id}"},
+	{Method: "DELETE", Path: "/public/v1/contacts/{id}"},
+
+	// Templates (8)
+	{Method: "GET", Path: "/public/v1/templates"},
+	{Method: "POST", Path: "/public/v1/templates"},
+	{Method: "POST", Path: "/public/v1/templates?upload"},
+	{Method: "GET", Path: "/public/v1/templates/{id}"},
+	{Method: "GET", Path: "/public/v1/templates/{id}/details"},
+	{Method: "PATCH", Path: "/public/v1/templates/{id}"},
+	{Method: "DELETE", Path: "/public/v1/templates/{id}"},
+	{Method: "POST", Path: "/public/v1/templates/{id}/editing-sessions"},
+
+	// Folders (6)
+	{Method: "GET", Path: "/public/v1/documents/folders"},
+	{Method: "POST", Path: "/public/v1/documents/folders"},
+	{Method: "PUT", Path: "/public/v1/documents/folders/{id}"},
+	{Method: "GET", Path: "/public/v1/templates/folders"},
+	{Method: "POST", Path: "/public/v1/templates/folders"},
+	{Method: "PUT", Path: "/public/v1/templates/folders/{id}"},
+
+	// Document recipients (4)
+	{Method: "POST", Path: "/public/v1/documents/{id}/recipients"},
+	{Method: "PATCH", Path: "/public/v1/documents/{id}/recipients/recipient/{recipient_id}"},
+	{Method: "DELETE", Path: "/public/v1/documents/{id}/recipients/{recipient_id}"},
+	{Method: "POST", Path: "/public/v1/documents/{id}/recipients/{recipient_id}/reassign"},
+
+	// Document attachments (6)
+	{Method: "GET", Path: "/public/v1/documents/{id}/attachments"},
+	{Method: "POST", Path: "/public/v1/documents/{id}/attachments"},
+	{Method: "POST", Path: "/public/v1/documents/{id}/attachments?upload"},
+	{Method: "GET", Path: "/public/v1/documents/{id}/attachments/{attachment_id}"},
+	{Method: "DELETE", Path: "/public/v1/documents/{id}/attachments/{attachment_id}"},
+	{Method: "GET", Path: "/public/v1/documents/{id}/attachments/{attachment_id}/download"},
+
+	// Document fields (2)
+	{Method: "GET", Path: "/public/v1/documents/{id}/fields"},
+	{Method: "POST", Path: "/public/v1/documents/{id}/fields"},
+
+	// Document sections (6)
+	{Method: "GET", Path: "/public/v1/documents/{document_id}/sections"},
+	{Method: "POST", Path: "/public/v1/documents/{document_id}/sections/uploads"},
+	{Method: "POST", Path: "/public/v1/documents/{document_id}/sections/uploads?upload"},
+	{Method: "GET", Path: "/public/v1/documents/{document_id}/sections/uploads/{upload_id}"},
+	{Method: "GET", Path: "/public/v1/documents/{document_id}/sections/{section_id}"},
+	{Method: "DELETE", Path: "/public/v1/documents/{document_id}/sections/{section_id}"},
+
+	// Document reminders (4)
+	{Method: "GET", Path: "/public/v1/documents/{document_id}/auto-reminders"},
+	{Method: "PATCH", Path: "/public/v1/documents/{document_id}/auto-reminders"},
+	{Method: "GET", Path: "/public/v1/documents/{document_id}/auto-reminders/status"},
+	{Method: "POST", Path: "/public/v1/documents/{document_id}/send-reminder"},
+
+	// Document audit trail (1)
+	{Method: "GET", Path: "/public/v2/documents/{document_id}/audit-trail"},
+
+	// Settings (4)
+	{Method: "GET", Path: "/public/v2/documents/{document_id}/settings"},
+	{Method: "PATCH", Path: "/public/v2/documents/{document_id}/settings"},
+	{Method: "GET", Path: "/public/v2/templates/{template_id}/settings"},
+	{Method: "PATCH", Path: "/public/v2/templates/{template_id}/settings"},
+
+	// Content library items (5)
+	{Method: "GET", Path: "/public/v1/content-library-items"},
+	{Method: "POST", Path: "/public/v1/content-library-items"},
+	{Method: "POST", Path: "/public/v1/content-library-items?upload"},
+	{Method: "GET", Path: "/public/v1/content-library-items/{id}"},
+	{Method: "GET", Path: "/public/v1/content-library-items/{id}/details"},
+
+	// Linked objects (4)
+	{Method: "GET", Path: "/public/v1/documents/linked-objects"},
+	{Method: "GET", Path: "/public/v1/documents/{id}/linked-objects"},
+	{Method: "POST", Path: "/public/v1/documents/{id}/linked-objects"},
+	{Method: "DELETE", Path: "/public/v1/documents/{id}/linked-objects/{linked_object_id}"},
+
+	// Quotes (1)
+	{Method: "PUT", Path: "/public/v1/documents/{document_id}/quotes/{quote_id}"},
+
+	// Members (4)
+	{Method: "GET", Path: "/public/v1/members"},
+	{Method: "GET", Path: "/public/v1/members/current"},
+	{Method: "GET", Path: "/public/v1/members/{id}"},
+	{Method: "POST", Path: "/public/v1/members/{member_id}/token"},
+
+	// Workspaces (6)
+	{Method: "GET", Path: "/public/v1/workspaces"},
+	{Method: "POST", Path: "/public/v1/workspaces"},
+	{Method: "POST", Path: "/public/v1/workspaces/{workspace_id}/deactivate"},
+	{Method: "POST", Path: "/public/v1/workspaces/{workspace_id}/members"},
+	{Method: "DELETE", Path: "/public/v1/workspaces/{workspace_id}/members/{member_id}"},
+	{Method: "POST", Path: "/public/v1/workspaces/{workspace_id}/api-keys"},
+
+	// Users (2)
+	{Method: "GET", Path: "/public/v1/users"},
+	{Method: "POST", Path: "/public/v1/users"},
+
+	// API Logs (4)
+	{Method: "GET", Path: "/public/v1/logs"},
+	{Method: "GET", Path: "/public/v1/logs/{id}"},
+	{Method: "GET", Path: "/public/v2/logs"},
+	{Method: "GET", Path: "/public/v2/logs/{id}"},
+
+	// Notary (4)
+	{Method: "GET", Path: "/public/v2/notary/notaries"},
+	{Method: "POST", Path: "/public/v2/notary/notarization-requests"},
+	{Method: "GET", Path: "/public/v2/notary/notarization-requests/{session_request_id}"},
+	{Method: "DELETE", Path: "/public/v2/notary/notarization-requests/{session_request_id}"},
+
+	// DOCX Export (2)
+	{Method: "POST", Path: "/public/beta/documents/{document_id}/docx-export-tasks"},
+	{Method: "GET", Path: "/public/beta/documents/{document_id}/docx-export-tasks/{task_id}"},
+
+	// Forms (1)
+	{Method: "GET", Path: "/public/v1/forms"},
+
+	// SMS Opt-outs (1)
+	{Method: "GET", Path: "/public/v1/sms-opt-outs"},
+
+	// Document Structure View (1)
+	{Method: "POST", Path: "/public/v2/dsv/{document_id}/add-named-items"},
 }
 
 func main() {
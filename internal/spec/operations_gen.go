@@ -7,6 +7,53 @@ const PinnedSpecVersion = "7.18.0"
 
 // CoveredOperations is the exact operation manifest supported by this SDK milestone.
 var CoveredOperations = []Operation{
+	{Method: "GET", Path: "/public/v1/logs", OperationID: "listLogs", Tag: "API Logs"},
+	{Method: "GET", Path: "/public/v1/logs/{id}", OperationID: "detailsLog", Tag: "API Logs"},
+	{Method: "GET", Path: "/public/v2/logs", OperationID: "listLogsV2", Tag: "API Logs"},
+	{Method: "GET", Path: "/public/v2/logs/{id}", OperationID: "detailsLogV2", Tag: "API Logs"},
+	{Method: "GET", Path: "/public/v1/sms-opt-outs", OperationID: "listRecentSmsOptOuts", Tag: "Communication Preferences"},
+	{Method: "GET", Path: "/public/v1/contacts", OperationID: "listContacts", Tag: "Contacts"},
+	{Method: "POST", Path: "/public/v1/contacts", OperationID: "createContact", Tag: "Contacts"},
+	{Method: "DELETE", Path: "/public/v1/contacts/{id}", OperationID: "deleteContact", Tag: "Contacts"},
+	{Method: "GET", Path: "/public/v1/contacts/{id}", OperationID: "detailsContact", Tag: "Contacts"},
+	{Method: "PATCH", Path: "/public/v1/contacts/{id}", OperationID: "updateContact", Tag: "Contacts"},
+	{Method: "GET", Path: "/public/v1/content-library-items", OperationID: "listContentLibraryItems", Tag: "Content Library Items"},
+	{Method: "POST", Path: "/public/v1/content-library-items", OperationID: "createContentLibraryItem", Tag: "Content Library Items"},
+	{Method: "GET", Path: "/public/v1/content-library-items/{id}", OperationID: "statusContentLibraryItem", Tag: "Content Library Items"},
+	{Method: "GET", Path: "/public/v1/content-library-items/{id}/details", OperationID: "detailsContentLibraryItem", Tag: "Content Library Items"},
+	{Method: "POST", Path: "/public/v1/content-library-items?upload", OperationID: "createContentLibraryItemFromUpload", Tag: "Content Library Items"},
+	{Method: "GET", Path: "/public/v1/documents/{id}/attachments", OperationID: "listDocumentAttachments", Tag: "Document Attachments"},
+	{Method: "POST", Path: "/public/v1/documents/{id}/attachments", OperationID: "createDocumentAttachment", Tag: "Document Attachments"},
+	{Method: "DELETE", Path: "/public/v1/documents/{id}/attachments/{attachment_id}", OperationID: "deleteDocumentAttachment", Tag: "Document Attachments"},
+	{Method: "GET", Path: "/public/v1/documents/{id}/attachments/{attachment_id}", OperationID: "detailsDocumentAttachment", Tag: "Document Attachments"},
+	{Method: "GET", Path: "/public/v1/documents/{id}/attachments/{attachment_id}/download", OperationID: "downloadDocumentAttachment", Tag: "Document Attachments"},
+	{Method: "POST", Path: "/public/v1/documents/{id}/attachments?upload", OperationID: "createDocumentAttachmentFromFileUpload", Tag: "Document Attachments"},
+	{Method: "GET", Path: "/public/v2/documents/{document_id}/audit-trail", OperationID: "listDocumentAuditTrail", Tag: "Document Audit Trail"},
+	{Method: "GET", Path: "/public/v1/documents/{id}/fields", OperationID: "listDocumentFields", Tag: "Document Fields"},
+	{Method: "POST", Path: "/public/v1/documents/{id}/fields", OperationID: "createDocumentFields", Tag: "Document Fields"},
+	{Method: "GET", Path: "/public/v1/documents/linked-objects", OperationID: "listDocumentsByLinkedObject", Tag: "Document Link to CRM"},
+	{Method: "GET", Path: "/public/v1/documents/{id}/linked-objects", OperationID: "listLinkedObjects", Tag: "Document Link to CRM"},
+	{Method: "POST", Path: "/public/v1/documents/{id}/linked-objects", OperationID: "createLinkedObject", Tag: "Document Link to CRM"},
+	{Method: "DELETE", Path: "/public/v1/documents/{id}/linked-objects/{linked_object_id}", OperationID: "deleteLinkedObject", Tag: "Document Link to CRM"},
+	{Method: "POST", Path: "/public/v1/documents/{id}/recipients", OperationID: "addDocumentRecipient", Tag: "Document Recipients"},
+	{Method: "PATCH", Path: "/public/v1/documents/{id}/recipients/recipient/{recipient_id}", OperationID: "editDocumentRecipient", Tag: "Document Recipients"},
+	{Method: "DELETE", Path: "/public/v1/documents/{id}/recipients/{recipient_id}", OperationID: "deleteDocumentRecipient", Tag: "Document Recipients"},
+	{Method: "POST", Path: "/public/v1/documents/{id}/recipients/{recipient_id}/reassign", OperationID: "reassignDocumentRecipient", Tag: "Document Recipients"},
+	{Method: "GET", Path: "/public/v1/documents/{document_id}/auto-reminders", OperationID: "getDocumentAutoReminderSettings", Tag: "Document Reminders"},
+	{Method: "PATCH", Path: "/public/v1/documents/{document_id}/auto-reminders", OperationID: "updateDocumentAutoReminderSettings", Tag: "Document Reminders"},
+	{Method: "GET", Path: "/public/v1/documents/{document_id}/auto-reminders/status", OperationID: "statusDocumentAutoReminder", Tag: "Document Reminders"},
+	{Method: "POST", Path: "/public/v1/documents/{document_id}/send-reminder", OperationID: "createManualReminder", Tag: "Document Reminders"},
+	{Method: "GET", Path: "/public/v1/documents/{document_id}/sections", OperationID: "listSections", Tag: "Document Sections (Bundles)"},
+	{Method: "POST", Path: "/public/v1/documents/{document_id}/sections/uploads", OperationID: "uploadSection", Tag: "Document Sections (Bundles)"},
+	{Method: "GET", Path: "/public/v1/documents/{document_id}/sections/uploads/{upload_id}", OperationID: "sectionDetails", Tag: "Document Sections (Bundles)"},
+	{Method: "POST", Path: "/public/v1/documents/{document_id}/sections/uploads?upload", OperationID: "uploadSectionWithUpload", Tag: "Document Sections (Bundles)"},
+	{Method: "DELETE", Path: "/public/v1/documents/{document_id}/sections/{section_id}", OperationID: "deleteSection", Tag: "Document Sections (Bundles)"},
+	{Method: "GET", Path: "/public/v1/documents/{document_id}/sections/{section_id}", OperationID: "sectionInfo", Tag: "Document Sections (Bundles)"},
+	{Method: "GET", Path: "/public/v2/documents/{document_id}/settings", OperationID: "documentSettingsGet", Tag: "Document Settings"},
+	{Method: "PATCH", Path: "/public/v2/documents/{document_id}/settings", OperationID: "documentSettingsUpdate", Tag: "Document Settings"},
+	{Method: "POST", Path: "/public/v2/dsv/{document_id}/add-named-items", OperationID: "addDsvNamedItems", Tag: "Document Structure View"},
+	{Method: "POST", Path: "/public/beta/documents/{document_id}/docx-export-tasks", OperationID: "createExportDocxTask", Tag: "Documents"},
+	{Method: "GET", Path: "/public/beta/documents/{document_id}/docx-export-tasks/{task_id}", OperationID: "getDocxExportTask", Tag: "Documents"},
 	{Method: "GET", Path: "/public/v1/documents", OperationID: "listDocuments", Tag: "Documents"},
 	{Method: "POST", Path: "/public/v1/documents", OperationID: "createDocument", Tag: "Documents"},
 	{Method: "PATCH", Path: "/public/v1/documents/ownership", OperationID: "transferAllDocumentsOwnership", Tag: "Documents"},
@@ -27,12 +74,46 @@ var CoveredOperations = []Operation{
 	{Method: "PATCH", Path: "/public/v1/documents/{id}/status", OperationID: "changeDocumentStatus", Tag: "Documents"},
 	{Method: "PATCH", Path: "/public/v1/documents/{id}/status?upload", OperationID: "changeDocumentStatusWithUpload", Tag: "Documents"},
 	{Method: "POST", Path: "/public/v1/documents?upload", OperationID: "createDocumentFromUpload", Tag: "Documents"},
+	{Method: "GET", Path: "/public/v1/documents/folders", OperationID: "listDocumentFolders", Tag: "Folders"},
+	{Method: "POST", Path: "/public/v1/documents/folders", OperationID: "createDocumentFolder", Tag: "Folders"},
+	{Method: "PUT", Path: "/public/v1/documents/folders/{id}", OperationID: "renameDocumentFolder", Tag: "Folders"},
+	{Method: "GET", Path: "/public/v1/templates/folders", OperationID: "listTemplateFolders", Tag: "Folders"},
+	{Method: "POST", Path: "/public/v1/templates/folders", OperationID: "createTemplateFolder", Tag: "Folders"},
+	{Method: "PUT", Path: "/public/v1/templates/folders/{id}", OperationID: "renameTemplateFolder", Tag: "Folders"},
+	{Method: "GET", Path: "/public/v1/forms", OperationID: "listForm", Tag: "Forms"},
+	{Method: "GET", Path: "/public/v1/members", OperationID: "listMembers", Tag: "Members"},
+	{Method: "GET", Path: "/public/v1/members/current", OperationID: "detailsCurrentMember", Tag: "Members"},
+	{Method: "GET", Path: "/public/v1/members/{id}", OperationID: "detailsMember", Tag: "Members"},
+	{Method: "POST", Path: "/public/v1/members/{member_id}/token", OperationID: "createMemberToken", Tag: "Members"},
+	{Method: "GET", Path: "/public/v2/notary/notaries", OperationID: "listNotaries", Tag: "Notary"},
+	{Method: "POST", Path: "/public/v2/notary/notarization-requests", OperationID: "createNotarizationRequest", Tag: "Notary"},
+	{Method: "DELETE", Path: "/public/v2/notary/notarization-requests/{session_request_id}", OperationID: "deleteNotarizationRequest", Tag: "Notary"},
+	{Method: "GET", Path: "/public/v2/notary/notarization-requests/{session_request_id}", OperationID: "notarizationRequestDetails", Tag: "Notary"},
 	{Method: "POST", Path: "/oauth2/access_token", OperationID: "accessToken", Tag: "OAuth 2.0 Authentication"},
 	{Method: "POST", Path: "/public/v2/product-catalog/items", OperationID: "createCatalogItem", Tag: "Product catalog"},
 	{Method: "GET", Path: "/public/v2/product-catalog/items/search", OperationID: "searchCatalogItems", Tag: "Product catalog"},
 	{Method: "DELETE", Path: "/public/v2/product-catalog/items/{item_uuid}", OperationID: "deleteCatalogItem", Tag: "Product catalog"},
 	{Method: "GET", Path: "/public/v2/product-catalog/items/{item_uuid}", OperationID: "getCatalogItem", Tag: "Product catalog"},
 	{Method: "PATCH", Path: "/public/v2/product-catalog/items/{item_uuid}", OperationID: "updateCatalogItem", Tag: "Product catalog"},
+	{Method: "PUT", Path: "/public/v1/documents/{document_id}/quotes/{quote_id}", OperationID: "quoteUpdate", Tag: "Quotes"},
+	{Method: "GET", Path: "/public/v2/templates/{template_id}/settings", OperationID: "templateSettingsGet", Tag: "Template Settings"},
+	{Method: "PATCH", Path: "/public/v2/templates/{template_id}/settings", OperationID: "templateSettingsUpdate", Tag: "Template Settings"},
+	{Method: "GET", Path: "/public/v1/templates", OperationID: "listTemplates", Tag: "Templates"},
+	{Method: "POST", Path: "/public/v1/templates", OperationID: "createTemplate", Tag: "Templates"},
+	{Method: "DELETE", Path: "/public/v1/templates/{id}", OperationID: "deleteTemplate", Tag: "Templates"},
+	{Method: "GET", Path: "/public/v1/templates/{id}", OperationID: "statusTemplate", Tag: "Templates"},
+	{Method: "PATCH", Path: "/public/v1/templates/{id}", OperationID: "updateTemplate", Tag: "Templates"},
+	{Method: "GET", Path: "/public/v1/templates/{id}/details", OperationID: "detailsTemplate", Tag: "Templates"},
+	{Method: "POST", Path: "/public/v1/templates/{id}/editing-sessions", OperationID: "createTemplateEditingSession", Tag: "Templates"},
+	{Method: "POST", Path: "/public/v1/templates?upload", OperationID: "createTemplateWithUpload", Tag: "Templates"},
+	{Method: "GET", Path: "/public/v1/users", OperationID: "listUsers", Tag: "User and Workspace management"},
+	{Method: "POST", Path: "/public/v1/users", OperationID: "createUser", Tag: "User and Workspace management"},
+	{Method: "GET", Path: "/public/v1/workspaces", OperationID: "getWorkspacesList", Tag: "User and Workspace management"},
+	{Method: "POST", Path: "/public/v1/workspaces", OperationID: "createWorkspace", Tag: "User and Workspace management"},
+	{Method: "POST", Path: "/public/v1/workspaces/{workspace_id}/api-keys", OperationID: "createApiKey", Tag: "User and Workspace management"},
+	{Method: "POST", Path: "/public/v1/workspaces/{workspace_id}/deactivate", OperationID: "deactivateWorkspace", Tag: "User and Workspace management"},
+	{Method: "POST", Path: "/public/v1/workspaces/{workspace_id}/members", OperationID: "addMember", Tag: "User and Workspace management"},
+	{Method: "DELETE", Path: "/public/v1/workspaces/{workspace_id}/members/{member_id}", OperationID: "removeMember", Tag: "User and Workspace management"},
 	{Method: "GET", Path: "/public/v1/webhook-events", OperationID: "listWebhookEvent", Tag: "Webhook events"},
 	{Method: "GET", Path: "/public/v1/webhook-events/{id}", OperationID: "detailsWebhookEvent", Tag: "Webhook events"},
 	{Method: "GET", Path: "/public/v1/webhook-subscriptions", OperationID: "listWebhookSubscriptions", Tag: "Webhook subscriptions"},
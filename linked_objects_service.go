@@ -0,0 +1,104 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// linkedObjectsService implements LinkedObjectsService.
+type linkedObjectsService struct {
+	client *Client
+}
+
+// List lists CRM objects linked to a document.
+func (s *linkedObjectsService) List(ctx context.Context, documentID string) (*LinkedObjectListResponse, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out LinkedObjectListResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/documents/" + escapedID + "/linked-objects",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Create links a CRM record to a document.
+func (s *linkedObjectsService) Create(ctx context.Context, documentID string, ref LinkedObjectRef) (*LinkedObject, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	if err = ref.validate(); err != nil {
+		return nil, err
+	}
+
+	var out LinkedObject
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/documents/" + escapedID + "/linked-objects",
+		requireAuth: true,
+		jsonBody:    ref,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Delete removes a CRM link from a document.
+func (s *linkedObjectsService) Delete(ctx context.Context, documentID, linkedObjectID string) error {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return err
+	}
+	escapedLinkID, err := escapePathParam(linkedObjectID)
+	if err != nil {
+		return fmt.Errorf("linked object id: %w", err)
+	}
+
+	return s.client.decodeJSON(ctx, &request{
+		method:         http.MethodDelete,
+		path:           "/public/v1/documents/" + escapedID + "/linked-objects/" + escapedLinkID,
+		requireAuth:    true,
+		expectedStatus: []int{http.StatusNoContent},
+	}, nil)
+}
+
+// ListDocuments returns documents linked to a CRM record.
+func (s *linkedObjectsService) ListDocuments(ctx context.Context, ref LinkedObjectRef, opts *ListDocumentsByLinkedObjectOptions) ([]DocumentSummary, error) {
+	if err := ref.validate(); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("provider", ref.Provider)
+	query.Set("entity_type", ref.EntityType)
+	query.Set("entity_id", ref.EntityID)
+	if opts != nil {
+		setIfNotEmpty(query, "order_by", opts.OrderBy)
+		for _, v := range opts.OwnerIDs {
+			query.Add("owner_ids", v)
+		}
+	}
+
+	var out []DocumentSummary
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/documents/linked-objects",
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
@@ -0,0 +1,99 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestLinkedObjectsService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/linked-objects":
+			q := r.URL.Query()
+			assertQueryEq(t, q, "provider", "hubspot")
+			assertQueryEq(t, q, "entity_type", "deal")
+			assertQueryEq(t, q, "entity_id", "12345")
+			assertQueryEq(t, q, "order_by", "-date_created")
+			if got := q["owner_ids"]; len(got) != 2 {
+				t.Fatalf("unexpected owner_ids: %v", got)
+			}
+			_, _ = io.WriteString(w, `[{"id":"doc1","status":"document.sent","template_id":"tpl1","date_created":"2026-01-01T00:00:00Z"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/doc1/linked-objects":
+			_, _ = io.WriteString(w, `{"linked_objects":[{"id":"l1","provider":"salesforce-oauth2","entity_type":"opportunity","entity_id":"006","children":[{"id":"c1","entity_type":"quote","entity_id":"0Q0"}]}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/documents/doc1/linked-objects":
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if payload["provider"] != "hubspot" || payload["entity_type"] != "deal" || payload["entity_id"] != "12345" {
+				t.Fatalf("unexpected create payload: %+v", payload)
+			}
+			_, _ = io.WriteString(w, `{"id":"l2","provider":"hubspot","entity_type":"deal","entity_id":"12345"}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/public/v1/documents/doc1/linked-objects/l2":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.LinkedObjects()
+	deal := LinkedObjectRef{Provider: "hubspot", EntityType: "deal", EntityID: "12345"}
+
+	docs, err := svc.ListDocuments(ctx, deal, &ListDocumentsByLinkedObjectOptions{OrderBy: "-date_created", OwnerIDs: []string{"o1", "o2"}})
+	if err != nil || len(docs) != 1 || docs[0].ID != "doc1" || docs[0].TemplateID != "tpl1" {
+		t.Fatalf("ListDocuments failed: %v %+v", err, docs)
+	}
+
+	list, err := svc.List(ctx, "doc1")
+	if err != nil || len(list.LinkedObjects) != 1 || len(list.LinkedObjects[0].Children) != 1 {
+		t.Fatalf("List failed: %v %+v", err, list)
+	}
+
+	link, err := svc.Create(ctx, "doc1", deal)
+	if err != nil || link.ID != "l2" {
+		t.Fatalf("Create failed: %v %+v", err, link)
+	}
+
+	if err = svc.Delete(ctx, "doc1", link.ID); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+}
+
+func TestLinkedObjectsService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	svc := client.LinkedObjects()
+
+	if _, err := svc.List(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+	if _, err := svc.Create(ctx, "doc1", LinkedObjectRef{Provider: "hubspot", EntityType: "deal"}); !errors.Is(err, ErrIncompleteLinkedObject) {
+		t.Fatalf("expected incomplete linked object error, got %v", err)
+	}
+	if _, err := svc.Create(ctx, "", LinkedObjectRef{}); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+	if err := svc.Delete(ctx, "doc1", ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty linked object id error, got %v", err)
+	}
+	if err := svc.Delete(ctx, "", "l1"); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty document id error, got %v", err)
+	}
+	if _, err := svc.ListDocuments(ctx, LinkedObjectRef{EntityType: "deal", EntityID: "1"}, nil); !errors.Is(err, ErrIncompleteLinkedObject) {
+		t.Fatalf("expected incomplete linked object error, got %v", err)
+	}
+}
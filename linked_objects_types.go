@@ -0,0 +1,27 @@
+package pandadoc
+
+// LinkedObjectRef identifies a CRM record by provider, entity type, and entity ID,
+// for example {"hubspot", "deal", "12345"}.
+type LinkedObjectRef struct {
+	Provider   string `json:"provider"`
+	EntityType string `json:"entity_type"`
+	EntityID   string `json:"entity_id"`
+}
+
+// LinkedObjectListResponse is returned by list linked objects endpoint.
+type LinkedObjectListResponse struct {
+	LinkedObjects []LinkedObject `json:"linked_objects"`
+}
+
+// ListDocumentsByLinkedObjectOptions controls lookup of documents linked to a CRM record.
+type ListDocumentsByLinkedObjectOptions struct {
+	OrderBy  string
+	OwnerIDs []string
+}
+
+func (r LinkedObjectRef) validate() error {
+	if r.Provider == "" || r.EntityType == "" || r.EntityID == "" {
+		return ErrIncompleteLinkedObject
+	}
+	return nil
+}
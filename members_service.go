@@ -0,0 +1,84 @@
+package pandadoc
+
+import (
+	"context"
+	"net/http"
+)
+
+// membersService implements MembersService.
+type membersService struct {
+	client *Client
+}
+
+// List lists workspace members.
+func (s *membersService) List(ctx context.Context) (*MemberListResponse, error) {
+	var out MemberListResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/members",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Current returns the member the client's credentials belong to.
+func (s *membersService) Current(ctx context.Context) (*Member, error) {
+	var out Member
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/members/current",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Get returns member details by user id.
+func (s *membersService) Get(ctx context.Context, id string) (*Member, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var out Member
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/members/" + escapedID,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateToken creates a session token for a member.
+//
+// This endpoint requires an org-admin API key and must be enabled by PandaDoc.
+// Pass the token to Client.AsMember to act on the member's behalf.
+func (s *membersService) CreateToken(ctx context.Context, memberID string, reqBody *CreateMemberTokenRequest) (*MemberToken, error) {
+	escapedID, err := escapePathParam(memberID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		reqBody = &CreateMemberTokenRequest{}
+	}
+
+	var out MemberToken
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/members/" + escapedID + "/token",
+		requireAuth: true,
+		jsonBody:    reqBody,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,122 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestMembersService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	const member = `{"user_id":"u1","membership_id":"m1","email":"jane@example.com","first_name":"Jane","is_active":true,"role":"Admin","workspace":"w1","workspace_name":"Sales"}`
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/members":
+			_, _ = io.WriteString(w, `{"results":[`+member+`]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/members/current":
+			_, _ = io.WriteString(w, member)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/members/u1":
+			_, _ = io.WriteString(w, member)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/members/u1/token":
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if payload["lifetime"] != float64(3600) {
+				t.Fatalf("unexpected token payload: %+v", payload)
+			}
+			_, _ = io.WriteString(w, `{"token":"member-token"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.Members()
+
+	list, err := svc.List(ctx)
+	if err != nil || len(list.Results) != 1 || list.Results[0].MembershipID != "m1" {
+		t.Fatalf("List failed: %v %+v", err, list)
+	}
+
+	current, err := svc.Current(ctx)
+	if err != nil || current.Email != "jane@example.com" || !current.IsActive {
+		t.Fatalf("Current failed: %v %+v", err, current)
+	}
+
+	details, err := svc.Get(ctx, "u1")
+	if err != nil || details.WorkspaceName != "Sales" {
+		t.Fatalf("Get failed: %v %+v", err, details)
+	}
+
+	token, err := svc.CreateToken(ctx, "u1", &CreateMemberTokenRequest{Lifetime: 3600})
+	if err != nil || token.Token != "member-token" {
+		t.Fatalf("CreateToken failed: %v %+v", err, token)
+	}
+}
+
+func TestClient_AsMember(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/public/v1/members/u1/token":
+			if got := r.Header.Get("Authorization"); got != "API-Key test-api-key" {
+				t.Fatalf("unexpected admin auth header: %q", got)
+			}
+			_, _ = io.WriteString(w, `{"token":"member-token"}`)
+		case "/public/v1/members/current":
+			if got := r.Header.Get("Authorization"); got != "Bearer member-token" {
+				t.Fatalf("unexpected member auth header: %q", got)
+			}
+			_, _ = io.WriteString(w, `{"user_id":"u1"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	token, err := client.Members().CreateToken(ctx, "u1", nil)
+	if err != nil {
+		t.Fatalf("CreateToken failed: %v", err)
+	}
+
+	memberClient, err := client.AsMember(token.Token)
+	if err != nil {
+		t.Fatalf("AsMember failed: %v", err)
+	}
+	if memberClient == client || memberClient.Members() == client.Members() {
+		t.Fatalf("expected an independent client")
+	}
+
+	me, err := memberClient.Members().Current(ctx)
+	if err != nil || me.UserID != "u1" {
+		t.Fatalf("Current as member failed: %v %+v", err, me)
+	}
+
+	if _, err = client.AsMember("  "); !errors.Is(err, ErrMissingAuthentication) {
+		t.Fatalf("expected missing auth error, got %v", err)
+	}
+}
+
+func TestMembersService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	if _, err := client.Members().Get(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty id error, got %v", err)
+	}
+	if _, err := client.Members().CreateToken(ctx, " ", nil); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty member id error, got %v", err)
+	}
+}
@@ -0,0 +1,35 @@
+package pandadoc
+
+// Member models a workspace member.
+type Member struct {
+	UserID         string `json:"user_id,omitempty"`
+	MembershipID   string `json:"membership_id,omitempty"`
+	Email          string `json:"email,omitempty"`
+	FirstName      string `json:"first_name,omitempty"`
+	LastName       string `json:"last_name,omitempty"`
+	IsActive       bool   `json:"is_active"`
+	EmailsVerified bool   `json:"emails_verified"`
+	Role           string `json:"role,omitempty"`
+	UserLicense    string `json:"user_license,omitempty"`
+	Workspace      string `json:"workspace,omitempty"`
+	WorkspaceName  string `json:"workspace_name,omitempty"`
+	DateCreated    string `json:"date_created,omitempty"`
+	DateModified   string `json:"date_modified,omitempty"`
+}
+
+// MemberListResponse models list members response.
+type MemberListResponse struct {
+	Results []Member `json:"results"`
+}
+
+// CreateMemberTokenRequest models create member token payload.
+//
+// Lifetime is in seconds; zero lets PandaDoc apply its default.
+type CreateMemberTokenRequest struct {
+	Lifetime int `json:"lifetime,omitempty"`
+}
+
+// MemberToken models a member session token.
+type MemberToken struct {
+	Token string `json:"token"`
+}
@@ -0,0 +1,118 @@
+package pandadoc
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/mrz1836/go-pandadoc/internal/spec"
+)
+
+// Call describes a logical SDK call, or one physical HTTP attempt within it.
+//
+// Every SDK method makes one logical call (Attempt 0), which runs one or more
+// physical attempts (Attempt 1, 2, ...) as retries happen.
+type Call struct {
+	// OperationID is the PandaDoc OpenAPI operation ID, or empty when unknown.
+	OperationID string
+	// Method is the HTTP method.
+	Method string
+	// Path is the request path relative to the base URL, or the absolute URL
+	// of a pre-signed download.
+	Path string
+	// Attempt is 0 for the logical call and 1-based for physical attempts.
+	Attempt int
+	// Request is the outgoing HTTP request; it is set for physical attempts only.
+	Request *http.Request
+
+	// RetryMode is the call's retry mode resolved from the RetryPolicy.
+	// Logical-call middleware may change it for all attempts.
+	RetryMode RetryMode
+	// IdempotencyKey is sent in the Idempotency-Key header when set. It comes
+	// from ContextWithIdempotencyKey; logical-call middleware may set it.
+	IdempotencyKey string
+	// Retry is set on physical attempts once the response or error is known,
+	// so middleware can read it after calling next.
+	Retry *RetryDecision
+}
+
+// IsAttempt reports whether the call is a physical HTTP attempt.
+func (c *Call) IsAttempt() bool {
+	return c.Attempt > 0
+}
+
+// Handler executes a call.
+//
+// For physical attempts it returns the raw HTTP response, whatever its status.
+// For logical calls it returns the final successful response or the final error,
+// such as an *APIError. Handlers must not consume the response body.
+type Handler func(ctx context.Context, call *Call) (*http.Response, error)
+
+// Middleware wraps a Handler to observe or alter calls and attempts.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adds middleware around every logical call and physical attempt.
+//
+// Middleware runs in the order given, across repeated options; the first one is
+// the outermost. Use Call.IsAttempt to tell the two layers apart.
+func WithMiddleware(middleware ...Middleware) Option {
+	return func(cfg *clientConfig) error {
+		for _, m := range middleware {
+			if m != nil {
+				cfg.middleware = append(cfg.middleware, m)
+			}
+		}
+		return nil
+	}
+}
+
+// withMiddleware wraps h in the client's middleware chain.
+func (c *Client) withMiddleware(h Handler) Handler {
+	for i := len(c.middleware) - 1; i >= 0; i-- {
+		h = c.middleware[i](h)
+	}
+	return h
+}
+
+// operationID returns the spec operation ID for a request path such as
+// "/public/v1/documents/abc/send", preferring the template with the most literal segments.
+func operationID(method, path string) string {
+	path, query, _ := strings.Cut(path, "?")
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	best, bestScore := "", -1
+	for _, op := range spec.CoveredOperations {
+		if op.Method != method {
+			continue
+		}
+		opPath, opQuery, _ := strings.Cut(op.Path, "?")
+		if opQuery != query {
+			continue
+		}
+		if score, ok := matchTemplate(strings.Split(strings.Trim(opPath, "/"), "/"), segments); ok && score > bestScore {
+			best, bestScore = op.OperationID, score
+		}
+	}
+	return best
+}
+
+// matchTemplate matches path segments against a template, returning the number of literal matches.
+func matchTemplate(template, segments []string) (int, bool) {
+	if len(template) != len(segments) {
+		return 0, false
+	}
+	score := 0
+	for i, part := range template {
+		switch {
+		case strings.HasPrefix(part, "{") && strings.HasSuffix(part, "}"):
+			if segments[i] == "" {
+				return 0, false
+			}
+		case part == segments[i]:
+			score++
+		default:
+			return 0, false
+		}
+	}
+	return score, true
+}
@@ -0,0 +1,136 @@
+package pandadoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestWithMiddleware_LogicalCallsAndAttempts(t *testing.T) {
+	t.Parallel()
+
+	var (
+		mu      sync.Mutex
+		trace   []string
+		headers []string
+	)
+	record := func(name string) Middleware {
+		return func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) (*http.Response, error) {
+				resp, err := next(ctx, call)
+				status := 0
+				if resp != nil {
+					status = resp.StatusCode
+				}
+				mu.Lock()
+				trace = append(trace, fmt.Sprintf("%s %s %s %s #%d -> %d %v", name, call.OperationID, call.Method, call.Path, call.Attempt, status, err != nil))
+				mu.Unlock()
+				return resp, err
+			}
+		}
+	}
+	tagAttempts := func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*http.Response, error) {
+			if call.IsAttempt() {
+				call.Request.Header.Set("X-Attempt", fmt.Sprint(call.Attempt))
+			} else if call.Request != nil {
+				t.Fatalf("logical calls should not carry a request")
+			}
+			return next(ctx, call)
+		}
+	}
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("X-Attempt"))
+		switch {
+		case r.URL.Path == "/public/v1/documents/doc1/details" && len(headers) == 1:
+			w.WriteHeader(http.StatusServiceUnavailable)
+		case r.URL.Path == "/public/v1/documents/doc1/details":
+			_, _ = io.WriteString(w, `{"id":"doc1"}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"detail":"Not found"}`)
+		}
+	},
+		WithRetryPolicy(RetryPolicy{MaxRetries: 1, InitialBackoff: 1, MaxBackoff: 1, RetryOn5xx: true}),
+		WithMiddleware(record("outer"), nil),
+		WithMiddleware(record("inner"), tagAttempts),
+	)
+
+	ctx := context.Background()
+	if _, err := client.Documents().Details(ctx, "doc1"); err != nil {
+		t.Fatalf("Details failed: %v", err)
+	}
+
+	expected := []string{
+		"inner detailsDocument GET /public/v1/documents/doc1/details #1 -> 503 false",
+		"outer detailsDocument GET /public/v1/documents/doc1/details #1 -> 503 false",
+		"inner detailsDocument GET /public/v1/documents/doc1/details #2 -> 200 false",
+		"outer detailsDocument GET /public/v1/documents/doc1/details #2 -> 200 false",
+		"inner detailsDocument GET /public/v1/documents/doc1/details #0 -> 200 false",
+		"outer detailsDocument GET /public/v1/documents/doc1/details #0 -> 200 false",
+	}
+	if strings.Join(trace, "\n") != strings.Join(expected, "\n") {
+		t.Fatalf("unexpected trace:\n%s", strings.Join(trace, "\n"))
+	}
+	if fmt.Sprint(headers) != "[1 2]" {
+		t.Fatalf("expected middleware to tag each attempt, got %v", headers)
+	}
+
+	trace = nil
+	if _, err := client.Templates().Details(ctx, "tpl1"); !IsNotFound(err) {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	if last := trace[len(trace)-1]; last != "outer detailsTemplate GET /public/v1/templates/tpl1/details #0 -> 0 true" {
+		t.Fatalf("expected logical call to see the final error, got %q", last)
+	}
+}
+
+func TestWithMiddleware_ShortCircuit(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	}, WithMiddleware(func(_ Handler) Handler {
+		return func(_ context.Context, call *Call) (*http.Response, error) {
+			if !call.IsAttempt() {
+				return nil, errTestDummy
+			}
+			return nil, fmt.Errorf("unexpected attempt %d", call.Attempt)
+		}
+	}))
+
+	if _, err := client.Documents().Status(context.Background(), "doc1"); !errors.Is(err, errTestDummy) {
+		t.Fatalf("expected middleware error, got %v", err)
+	}
+}
+
+func TestOperationID(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		method, path, want string
+	}{
+		{http.MethodGet, "/public/v1/documents/abc", "statusDocument"},
+		{http.MethodPatch, "/public/v1/documents/ownership", "transferAllDocumentsOwnership"},
+		{http.MethodPatch, "/public/v1/documents/abc", "updateDocument"},
+		{http.MethodPost, "/public/v1/documents?upload", "createDocumentFromUpload"},
+		{http.MethodPost, "/public/v1/documents", "createDocument"},
+		{http.MethodGet, "/public/v1/documents/linked-objects", "listDocumentsByLinkedObject"},
+		{http.MethodGet, "/public/v2/dsv/abc/add-named-items", ""},
+		{http.MethodGet, "/public/v1/unknown", ""},
+		{http.MethodGet, "/public/v1/documents//details", ""},
+	}
+	for _, tc := range cases {
+		if got := operationID(tc.method, tc.path); got != tc.want {
+			t.Fatalf("operationID(%s %s) = %q, want %q", tc.method, tc.path, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+package pandadoc
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"mime/multipart"
+	"net/textproto"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// multipartStream streams a multipart body through a pipe instead of buffering it.
+//
+// Files implementing io.Seeker are replayed from their starting offset and
+// io.ReaderAt-only files are always read from offset 0, so such uploads can be
+// retried. Any other reader can be sent only once. The body length is known up
+// front only when every file reports its size; otherwise it is sent chunked.
+type multipartStream struct {
+	payload  *multipartPayload
+	boundary string
+	starts   []int64
+	size     int64
+
+	mu     sync.Mutex
+	opened bool
+	prev   *io.PipeReader
+	done   chan struct{}
+}
+
+func newMultipartStream(payload *multipartPayload) (*multipartStream, error) {
+	s := &multipartStream{
+		payload:  payload,
+		boundary: multipart.NewWriter(io.Discard).Boundary(),
+		starts:   make([]int64, len(payload.Files)),
+	}
+	sizes := make([]int64, len(payload.Files))
+	for i, file := range payload.Files {
+		if file.Reader == nil {
+			return nil, ErrNilFileReader
+		}
+		start, size, err := fileExtent(file.Reader)
+		if err != nil {
+			return nil, fmt.Errorf("seek multipart file %q: %w", file.FieldName, err)
+		}
+		s.starts[i], sizes[i] = start, size
+	}
+	s.size = s.length(sizes)
+	return s, nil
+}
+
+// fileExtent returns the offset a file is read from and the number of bytes
+// left to read, or a size of -1 when it cannot be determined.
+func fileExtent(r io.Reader) (start, size int64, err error) {
+	switch f := r.(type) {
+	case io.Seeker:
+		if start, err = f.Seek(0, io.SeekCurrent); err != nil {
+			return 0, 0, err
+		}
+		end, err := f.Seek(0, io.SeekEnd)
+		if err != nil {
+			return 0, 0, err
+		}
+		if _, err = f.Seek(start, io.SeekStart); err != nil {
+			return 0, 0, err
+		}
+		return start, end - start, nil
+	case interface{ Size() int64 }:
+		if _, ok := r.(io.ReaderAt); ok {
+			return 0, f.Size(), nil
+		}
+	}
+	return 0, -1, nil
+}
+
+// length returns the encoded body size for the given file sizes, or -1 when
+// any of them is unknown.
+func (s *multipartStream) length(sizes []int64) int64 {
+	var total int64
+	empty := make([]io.Reader, len(sizes))
+	for i, size := range sizes {
+		if size < 0 {
+			return -1
+		}
+		total += size
+		empty[i] = strings.NewReader("")
+	}
+
+	var framing countingWriter
+	if err := s.write(&framing, empty); err != nil {
+		return -1
+	}
+	return total + int64(framing)
+}
+
+func (s *multipartStream) contentType() string {
+	return "multipart/form-data; boundary=" + s.boundary
+}
+
+// rewindable reports whether every file can be read again from the start.
+func (s *multipartStream) rewindable() bool {
+	for _, file := range s.payload.Files {
+		switch file.Reader.(type) {
+		case io.Seeker, io.ReaderAt:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// open rewinds the files and starts writing a fresh body into a pipe.
+//
+// It can be used as http.Request.GetBody; a previous body is closed and its
+// writer finished before the files are rewound.
+func (s *multipartStream) open() (io.ReadCloser, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.opened && !s.rewindable() {
+		return nil, ErrUploadNotRewindable
+	}
+	if s.prev != nil {
+		_ = s.prev.Close()
+		<-s.done
+	}
+
+	readers, err := s.rewind()
+	if err != nil {
+		return nil, err
+	}
+
+	pr, pw := io.Pipe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_ = pw.CloseWithError(s.write(pw, readers))
+	}()
+
+	s.opened, s.prev, s.done = true, pr, done
+	return pr, nil
+}
+
+// rewind returns a reader per file, positioned at the start of its content.
+func (s *multipartStream) rewind() ([]io.Reader, error) {
+	readers := make([]io.Reader, len(s.payload.Files))
+	for i, file := range s.payload.Files {
+		readers[i] = file.Reader
+		switch r := file.Reader.(type) {
+		case io.Seeker:
+			if !s.opened {
+				continue
+			}
+			if _, err := r.Seek(s.starts[i], io.SeekStart); err != nil {
+				return nil, fmt.Errorf("rewind multipart file %q: %w", file.FieldName, err)
+			}
+		case io.ReaderAt:
+			readers[i] = io.NewSectionReader(r, 0, math.MaxInt64)
+		}
+	}
+	return readers, nil
+}
+
+func (s *multipartStream) write(w io.Writer, readers []io.Reader) error {
+	writer := multipart.NewWriter(w)
+	if err := writer.SetBoundary(s.boundary); err != nil {
+		return fmt.Errorf("set multipart boundary: %w", err)
+	}
+
+	for k, v := range s.payload.Fields {
+		if err := writer.WriteField(k, v); err != nil {
+			return fmt.Errorf("write multipart field %q: %w", k, err)
+		}
+	}
+
+	for i, file := range s.payload.Files {
+		field := file.FieldName
+		if field == "" {
+			field = "file"
+		}
+		fileName := file.FileName
+		if fileName == "" {
+			fileName = "upload.bin"
+		}
+
+		part, err := writer.CreatePart(filePartHeader(field, fileName, file.ContentType))
+		if err != nil {
+			return fmt.Errorf("create multipart file %q: %w", field, err)
+		}
+		if _, err := io.Copy(part, readers[i]); err != nil {
+			return fmt.Errorf("copy multipart file %q: %w", field, err)
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("close multipart body: %w", err)
+	}
+	return nil
+}
+
+// countingWriter discards what is written to it and counts the bytes.
+type countingWriter int64
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	*c += countingWriter(len(p))
+	return len(p), nil
+}
+
+// filePartHeader builds a file part header, inferring the content type from
+// the file extension when none is given.
+func filePartHeader(field, fileName, contentType string) textproto.MIMEHeader {
+	if contentType == "" {
+		contentType = mime.TypeByExtension(filepath.Ext(fileName))
+	}
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	quote := strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+	h := make(textproto.MIMEHeader)
+	h.Set("Content-Disposition", fmt.Sprintf(`form-data; name="%s"; filename="%s"`,
+		quote.Replace(field), quote.Replace(fileName)))
+	h.Set("Content-Type", contentType)
+	return h
+}
@@ -0,0 +1,208 @@
+package pandadoc
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestMultipartStream_RetriesRewindableUpload(t *testing.T) {
+	t.Parallel()
+
+	var uploads []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("parse multipart: %v", err)
+		}
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("form file: %v", err)
+			return
+		}
+		data, _ := io.ReadAll(file)
+		uploads = append(uploads, r.Header.Get(IdempotencyKeyHeader)+" "+header.Header.Get("Content-Type")+" "+string(data))
+		if len(uploads) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, `{"id":"doc1"}`)
+	}, WithRetryPolicy(RetryPolicy{MaxRetries: 1, InitialBackoff: 1, MaxBackoff: 1, RetryOn5xx: true}))
+
+	ctx := ContextWithIdempotencyKey(context.Background(), "upload-1")
+	err := client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/documents?upload",
+		requireAuth: true,
+		multipart: &multipartPayload{Files: []multipartFile{{
+			FileName:    "contract.bin",
+			ContentType: "application/pdf",
+			Reader:      strings.NewReader("%PDF-1.7"),
+		}}},
+		expectedStatus: []int{http.StatusCreated},
+	}, nil)
+	if err != nil {
+		t.Fatalf("upload failed: %v", err)
+	}
+	if len(uploads) != 2 || uploads[0] != "upload-1 application/pdf %PDF-1.7" || uploads[1] != uploads[0] {
+		t.Fatalf("expected the full file on both attempts, got %q", uploads)
+	}
+}
+
+func TestMultipartStream_NonRewindableUploadIsNotRetried(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		_, _ = io.Copy(io.Discard, r.Body)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, WithRetryPolicy(RetryPolicy{MaxRetries: 2, InitialBackoff: 1, MaxBackoff: 1, RetryOn5xx: true}))
+
+	ctx := ContextWithIdempotencyKey(context.Background(), "upload-1")
+	err := client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/documents?upload",
+		requireAuth: true,
+		multipart: &multipartPayload{Files: []multipartFile{{
+			Reader: io.MultiReader(strings.NewReader("stream")),
+		}}},
+	}, nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("expected the 503 API error, got %v", err)
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single attempt, got %d", calls)
+	}
+}
+
+func TestMultipartStream_PartContentTypeAndLength(t *testing.T) {
+	t.Parallel()
+
+	type upload struct {
+		contentType string
+		length      int64
+		received    int64
+		chunked     bool
+	}
+	var uploads []upload
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		_, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			t.Errorf("parse content-type: %v", err)
+			return
+		}
+		part, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).NextPart()
+		if err != nil {
+			t.Errorf("read multipart: %v", err)
+			return
+		}
+		uploads = append(uploads, upload{
+			contentType: part.Header.Get("Content-Type"),
+			length:      r.ContentLength,
+			received:    int64(len(body)),
+			chunked:     len(r.TransferEncoding) > 0 && r.TransferEncoding[0] == "chunked",
+		})
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, `{"id":"u1"}`)
+	})
+
+	for _, file := range []io.Reader{
+		bytes.NewReader([]byte("%PDF-1.7")),
+		io.MultiReader(strings.NewReader("%PDF-1.7")),
+	} {
+		if _, err := client.Documents().CreateFromUpload(context.Background(), &CreateDocumentFromUploadRequest{
+			FileName:    "contract.pdf",
+			ContentType: "application/vnd.pandadoc.test",
+			File:        file,
+		}); err != nil {
+			t.Fatalf("CreateFromUpload failed: %v", err)
+		}
+	}
+
+	if len(uploads) != 2 {
+		t.Fatalf("expected two uploads, got %d", len(uploads))
+	}
+	for i, u := range uploads {
+		if u.contentType != "application/vnd.pandadoc.test" {
+			t.Fatalf("upload %d: expected the caller's part content type, got %q", i, u.contentType)
+		}
+	}
+	if sized := uploads[0]; sized.chunked || sized.length != sized.received {
+		t.Fatalf("expected a sized reader to send Content-Length %d, got %+v", sized.received, sized)
+	}
+	if streamed := uploads[1]; !streamed.chunked || streamed.length != -1 {
+		t.Fatalf("expected an unsized reader to be sent chunked, got %+v", streamed)
+	}
+}
+
+func TestMultipartStream_Rewind(t *testing.T) {
+	t.Parallel()
+
+	seeker := strings.NewReader("skip-content")
+	_, _ = seeker.Seek(5, io.SeekStart)
+	readerAt := struct {
+		io.Reader
+		io.ReaderAt
+	}{strings.NewReader("whole"), strings.NewReader("whole")}
+
+	stream, err := newMultipartStream(&multipartPayload{Files: []multipartFile{
+		{FieldName: "a", FileName: "a.pdf", Reader: seeker},
+		{FieldName: "b", FileName: "b", Reader: readerAt},
+	}})
+	if err != nil {
+		t.Fatalf("newMultipartStream failed: %v", err)
+	}
+	if !stream.rewindable() {
+		t.Fatalf("expected seeker and reader-at sources to be rewindable")
+	}
+
+	for i := range 2 {
+		parts := readMultipartFiles(t, stream)
+		if parts["a"] != "application/pdf content" || parts["b"] != "application/octet-stream whole" {
+			t.Fatalf("open %d: unexpected parts %q", i, parts)
+		}
+	}
+
+	once, err := newMultipartStream(&multipartPayload{Files: []multipartFile{{Reader: io.MultiReader(strings.NewReader("x"))}}})
+	if err != nil {
+		t.Fatalf("newMultipartStream failed: %v", err)
+	}
+	_ = readMultipartFiles(t, once)
+	if _, err := once.open(); !errors.Is(err, ErrUploadNotRewindable) {
+		t.Fatalf("expected ErrUploadNotRewindable, got %v", err)
+	}
+}
+
+func readMultipartFiles(t *testing.T, stream *multipartStream) map[string]string {
+	t.Helper()
+
+	payload := readEncodedBody(t, encodedBody{stream: stream})
+	_, params, err := mime.ParseMediaType(stream.contentType())
+	if err != nil {
+		t.Fatalf("parse media type failed: %v", err)
+	}
+
+	parts := map[string]string{}
+	mr := multipart.NewReader(bytes.NewReader(payload), params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			return parts
+		}
+		if err != nil {
+			t.Fatalf("read multipart part failed: %v", err)
+		}
+		data, _ := io.ReadAll(part)
+		parts[part.FormName()] = part.Header.Get("Content-Type") + " " + string(data)
+	}
+}
@@ -0,0 +1,121 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// notaryService implements NotaryService.
+type notaryService struct {
+	client *Client
+}
+
+// ListNotaries lists notaries available to the organization.
+func (s *notaryService) ListNotaries(ctx context.Context, opts *ListNotariesOptions) (*NotaryListResponse, error) {
+	query := url.Values{}
+	if opts != nil {
+		for _, status := range opts.Statuses {
+			query.Add("status", string(status))
+		}
+		for _, state := range opts.CommissionStates {
+			query.Add("commission_state", state)
+		}
+		setIfPositive(query, "offset", opts.Offset)
+		setIfPositive(query, "limit", opts.Limit)
+		setIfNotEmpty(query, "order_by", opts.OrderBy)
+	}
+
+	var out NotaryListResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v2/notary/notaries",
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateRequest creates a notarization request for a document.
+func (s *notaryService) CreateRequest(ctx context.Context, reqBody *CreateNotarizationRequest) (*NotarizationRequest, error) {
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out NotarizationRequest
+	err := s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPost,
+		path:           "/public/v2/notary/notarization-requests",
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetRequest returns notarization request details.
+func (s *notaryService) GetRequest(ctx context.Context, id string) (*NotarizationRequest, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var out NotarizationRequest
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v2/notary/notarization-requests/" + escapedID,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// DeleteRequest deletes a notarization request.
+func (s *notaryService) DeleteRequest(ctx context.Context, id string) error {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return err
+	}
+
+	return s.client.decodeJSON(ctx, &request{
+		method:         http.MethodDelete,
+		path:           "/public/v2/notary/notarization-requests/" + escapedID,
+		requireAuth:    true,
+		expectedStatus: []int{http.StatusNoContent},
+	}, nil)
+}
+
+// WaitForRequest polls a notarization request until it is finished.
+//
+// Sessions can take hours, so callers usually set opts.Interval and bound ctx.
+// A request that ends incomplete or cancelled returns ErrNotarizationNotCompleted.
+func (s *notaryService) WaitForRequest(ctx context.Context, id string, opts *PollOptions) (*NotarizationRequest, error) {
+	var last *NotarizationRequest
+	err := s.client.poll(ctx, opts, func(ctx context.Context) (bool, error) {
+		req, err := s.GetRequest(ctx, id)
+		if err != nil {
+			return false, err
+		}
+		last = req
+		if !req.Status.IsFinished() {
+			return false, nil
+		}
+		if req.Status != NotarizationStatusCompleted {
+			return false, fmt.Errorf("%w: %s is %s", ErrNotarizationNotCompleted, id, req.Status)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return last, nil
+}
@@ -0,0 +1,152 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNotaryService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v2/notary/notaries":
+			q := r.URL.Query()
+			if got := q["status"]; len(got) != 2 || got[0] != "ACTIVE" || got[1] != "INVITED" {
+				t.Fatalf("unexpected statuses: %v", got)
+			}
+			assertQueryEq(t, q, "commission_state", "TX")
+			assertQueryEq(t, q, "limit", "20")
+			assertQueryEq(t, q, "order_by", "-name")
+			_, _ = io.WriteString(w, `{"count":1,"results":[{"id":"n1","email":"notary@example.com","name":"Pat","commission_state":"TX","status":"ACTIVE"}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v2/notary/notarization-requests":
+			var payload struct {
+				DocumentID string         `json:"document_id"`
+				Invitation map[string]any `json:"invitation"`
+				Notary     map[string]any `json:"notary"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			invitees, _ := payload.Invitation["invitees"].([]any)
+			if payload.DocumentID != "doc1" || len(invitees) != 1 || payload.Notary["id"] != "n1" || payload.Notary["scheduled_at"] != "2026-03-01T15:00:00Z" {
+				t.Fatalf("unexpected create payload: %+v", payload)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id":"nr1","name":"Deed","status":"SENT","date_created":"2026-02-01T10:00:00Z","date_accepted":null,
+				"created_by":{"user_id":"u1","email":"me@example.com"},
+				"invitees":[{"id":"i1","email":"signer@example.com","notarization_link":"https://notary.example/i1"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v2/notary/notarization-requests/nr1":
+			_, _ = io.WriteString(w, `{"id":"nr1","document_id":"doc1","status":"COMPLETED","date_created":"2026-02-01T10:00:00Z",
+				"date_started":"2026-03-01T15:00:00Z","date_completed":"2026-03-01T15:30:00Z",
+				"recording":{"name":"session.mp4","size":1024,"url":"https://files.example/rec"},
+				"signed_documents":[{"document_name":"Deed.pdf","document_type":"COMBINED","size":2048,"url":"https://files.example/doc"}]}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/public/v2/notary/notarization-requests/nr1":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.Notary()
+
+	notaries, err := svc.ListNotaries(ctx, &ListNotariesOptions{
+		Statuses:         []NotaryStatus{NotaryStatusActive, NotaryStatusInvited},
+		CommissionStates: []string{"TX"},
+		Limit:            20,
+		OrderBy:          "-name",
+	})
+	if err != nil || notaries.Count != 1 || notaries.Results[0].Status != NotaryStatusActive || *notaries.Results[0].CommissionState != "TX" {
+		t.Fatalf("ListNotaries failed: %v %+v", err, notaries)
+	}
+
+	created, err := svc.CreateRequest(ctx, &CreateNotarizationRequest{
+		DocumentID: "doc1",
+		Invitation: NotarizationInvitation{Invitees: []NotarizationInviteeRequest{{Email: "signer@example.com"}}},
+		Notary:     &NotarizationNotaryAssignment{ID: "n1", ScheduledAt: time.Date(2026, 3, 1, 15, 0, 0, 0, time.UTC)},
+	})
+	if err != nil || created.Status != NotarizationStatusSent || created.DateAccepted != nil || created.Invitees[0].NotarizationLink == "" || created.CreatedBy.UserID != "u1" {
+		t.Fatalf("CreateRequest failed: %v %+v", err, created)
+	}
+
+	details, err := svc.GetRequest(ctx, "nr1")
+	if err != nil || !details.Status.IsFinished() || details.DateCompleted == nil || details.Recording.Name != "session.mp4" || details.SignedDocuments[0].DocumentType != "COMBINED" {
+		t.Fatalf("GetRequest failed: %v %+v", err, details)
+	}
+
+	if err = svc.DeleteRequest(ctx, "nr1"); err != nil {
+		t.Fatalf("DeleteRequest failed: %v", err)
+	}
+}
+
+func TestNotaryService_WaitForRequest(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/public/v2/notary/notarization-requests/live":
+			status := "LIVE"
+			if calls.Add(1) >= 3 {
+				status = "COMPLETED"
+			}
+			_, _ = io.WriteString(w, `{"id":"live","status":"`+status+`","date_created":"2026-02-01T10:00:00Z"}`)
+		case "/public/v2/notary/notarization-requests/cancelled":
+			_, _ = io.WriteString(w, `{"id":"cancelled","status":"CANCELLED","date_created":"2026-02-01T10:00:00Z"}`)
+		case "/public/v2/notary/notarization-requests/waiting":
+			_, _ = io.WriteString(w, `{"id":"waiting","status":"WAITING_FOR_NOTARY","date_created":"2026-02-01T10:00:00Z"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.Notary()
+
+	done, err := svc.WaitForRequest(ctx, "live", &PollOptions{Interval: time.Millisecond})
+	if err != nil || done.Status != NotarizationStatusCompleted || calls.Load() != 3 {
+		t.Fatalf("WaitForRequest failed: %v %+v (calls=%d)", err, done, calls.Load())
+	}
+	if _, err = svc.WaitForRequest(ctx, "cancelled", nil); !errors.Is(err, ErrNotarizationNotCompleted) {
+		t.Fatalf("expected not completed error, got %v", err)
+	}
+	if _, err = svc.WaitForRequest(ctx, "waiting", &PollOptions{MaxAttempts: 2}); !errors.Is(err, ErrPollAttemptsExhausted) {
+		t.Fatalf("expected attempts exhausted error, got %v", err)
+	}
+
+	if NotarizationStatusLive.IsFinished() || !NotarizationStatusIncomplete.IsFinished() || NotarizationStatus("UNKNOWN").IsFinished() {
+		t.Fatalf("unexpected IsFinished result")
+	}
+}
+
+func TestNotaryService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	svc := client.Notary()
+	if _, err := svc.CreateRequest(ctx, nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if _, err := svc.GetRequest(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty id error, got %v", err)
+	}
+	if err := svc.DeleteRequest(ctx, " "); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty id error, got %v", err)
+	}
+	if _, err := svc.WaitForRequest(ctx, "", nil); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty id error, got %v", err)
+	}
+}
@@ -0,0 +1,160 @@
+package pandadoc
+
+import "time"
+
+// NotaryStatus is the onboarding status of a notary.
+type NotaryStatus string
+
+// Supported notary statuses.
+const (
+	// NotaryStatusInvited represents a notary who was invited but has not onboarded.
+	NotaryStatusInvited NotaryStatus = "INVITED"
+	// NotaryStatusUnderReview represents a notary whose onboarding is being reviewed.
+	NotaryStatusUnderReview NotaryStatus = "UNDER_REVIEW"
+	// NotaryStatusActive represents a notary who can accept requests.
+	NotaryStatusActive NotaryStatus = "ACTIVE"
+	// NotaryStatusRejected represents a notary whose onboarding was rejected.
+	NotaryStatusRejected NotaryStatus = "REJECTED"
+	// NotaryStatusInactive represents a notary who can no longer accept requests.
+	NotaryStatusInactive NotaryStatus = "INACTIVE"
+)
+
+// NotarizationStatus is the lifecycle status of a notarization request.
+type NotarizationStatus string
+
+// Supported notarization statuses.
+const (
+	// NotarizationStatusDraft represents a request that has not been sent.
+	NotarizationStatusDraft NotarizationStatus = "DRAFT"
+	// NotarizationStatusSent represents a request sent to its signers.
+	NotarizationStatusSent NotarizationStatus = "SENT"
+	// NotarizationStatusWaitingForNotary represents a request waiting for a notary to accept it.
+	NotarizationStatusWaitingForNotary NotarizationStatus = "WAITING_FOR_NOTARY"
+	// NotarizationStatusAccepted represents a request a notary has accepted.
+	NotarizationStatusAccepted NotarizationStatus = "ACCEPTED"
+	// NotarizationStatusLive represents a notarization session in progress.
+	NotarizationStatusLive NotarizationStatus = "LIVE"
+	// NotarizationStatusCompleted represents a finished notarization.
+	NotarizationStatusCompleted NotarizationStatus = "COMPLETED"
+	// NotarizationStatusIncomplete represents a session that ended without notarizing.
+	NotarizationStatusIncomplete NotarizationStatus = "INCOMPLETE"
+	// NotarizationStatusCancelled represents a cancelled request.
+	NotarizationStatusCancelled NotarizationStatus = "CANCELLED"
+)
+
+// IsFinished reports whether the notarization request can no longer change.
+func (s NotarizationStatus) IsFinished() bool {
+	switch s {
+	case NotarizationStatusCompleted, NotarizationStatusIncomplete, NotarizationStatusCancelled:
+		return true
+	case NotarizationStatusDraft, NotarizationStatusSent, NotarizationStatusWaitingForNotary,
+		NotarizationStatusAccepted, NotarizationStatusLive:
+		return false
+	}
+	return false
+}
+
+// ListNotariesOptions controls list notaries query params.
+type ListNotariesOptions struct {
+	Statuses         []NotaryStatus
+	CommissionStates []string
+	Offset           int
+	Limit            int
+	OrderBy          string
+}
+
+// Notary models a notary available to the organization.
+type Notary struct {
+	ID              string       `json:"id"`
+	Email           string       `json:"email,omitempty"`
+	Name            *string      `json:"name,omitempty"`
+	CommissionState *string      `json:"commission_state,omitempty"`
+	Status          NotaryStatus `json:"status,omitempty"`
+}
+
+// NotaryListResponse models list notaries response.
+type NotaryListResponse struct {
+	Count   int      `json:"count"`
+	Results []Notary `json:"results"`
+}
+
+// NotarizationInviteeRequest identifies a signer invited to a notarization session.
+type NotarizationInviteeRequest struct {
+	Email     string `json:"email"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+}
+
+// NotarizationInvitation models the invitation sent to signers.
+type NotarizationInvitation struct {
+	Invitees []NotarizationInviteeRequest `json:"invitees,omitempty"`
+	Message  string                       `json:"message,omitempty"`
+}
+
+// NotarizationNotaryAssignment schedules a session with a specific notary.
+type NotarizationNotaryAssignment struct {
+	ID          string    `json:"id"`
+	ScheduledAt time.Time `json:"scheduled_at"`
+	Message     string    `json:"message,omitempty"`
+}
+
+// CreateNotarizationRequest models create notarization request payload.
+//
+// Leave Notary nil to let PandaDoc's notary network pick up the session.
+type CreateNotarizationRequest struct {
+	DocumentID                   string                        `json:"document_id"`
+	Invitation                   NotarizationInvitation        `json:"invitation"`
+	Notary                       *NotarizationNotaryAssignment `json:"notary,omitempty"`
+	DisableInviteesNotifications *bool                         `json:"disable_invitees_notifications,omitempty"`
+}
+
+// NotarizationCreator identifies the user who created a notarization request.
+type NotarizationCreator struct {
+	UserID    string `json:"user_id,omitempty"`
+	Email     string `json:"email,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+}
+
+// NotarizationInvitee models a signer on a notarization request.
+type NotarizationInvitee struct {
+	ID               string `json:"id"`
+	Email            string `json:"email,omitempty"`
+	FirstName        string `json:"first_name,omitempty"`
+	LastName         string `json:"last_name,omitempty"`
+	NotarizationLink string `json:"notarization_link,omitempty"`
+}
+
+// NotarizationRecording models the session recording.
+type NotarizationRecording struct {
+	Name string  `json:"name,omitempty"`
+	Size float64 `json:"size,omitempty"`
+	URL  string  `json:"url,omitempty"`
+}
+
+// NotarizedDocument models a signed document produced by a session.
+type NotarizedDocument struct {
+	DocumentName string  `json:"document_name,omitempty"`
+	DocumentType string  `json:"document_type,omitempty"`
+	Size         float64 `json:"size,omitempty"`
+	URL          string  `json:"url,omitempty"`
+}
+
+// NotarizationRequest models a notarization request.
+//
+// Create responses include invitee notarization links; detail responses add
+// document, timing, recording, and signed document information.
+type NotarizationRequest struct {
+	ID              string                 `json:"id"`
+	Name            string                 `json:"name,omitempty"`
+	DocumentID      string                 `json:"document_id,omitempty"`
+	Status          NotarizationStatus     `json:"status"`
+	CreatedBy       *NotarizationCreator   `json:"created_by,omitempty"`
+	Invitees        []NotarizationInvitee  `json:"invitees,omitempty"`
+	DateCreated     time.Time              `json:"date_created"`
+	DateAccepted    *time.Time             `json:"date_accepted,omitempty"`
+	DateStarted     *time.Time             `json:"date_started,omitempty"`
+	DateCompleted   *time.Time             `json:"date_completed,omitempty"`
+	Recording       *NotarizationRecording `json:"recording,omitempty"`
+	SignedDocuments []NotarizedDocument    `json:"signed_documents,omitempty"`
+}
@@ -2,6 +2,7 @@ package pandadoc
 
 import (
 	"fmt"
+	"maps"
 	"net/http"
 	"strings"
 	"time"
@@ -19,6 +20,9 @@ type clientConfig struct {
 	apiKey      string
 	accessToken string
 	logger      Logger
+	middleware  []Middleware
+	rateLimiter *rateLimiter
+	retryBudget *retryBudget
 }
 
 // RetryPolicy controls transport-level retries.
@@ -28,6 +32,19 @@ type RetryPolicy struct {
 	MaxBackoff     time.Duration
 	RetryOn429     bool
 	RetryOn5xx     bool
+
+	// Backoff spaces retries and polls. When nil, each delay is drawn with
+	// full jitter below a cap that doubles from InitialBackoff up to
+	// MaxBackoff. A Retry-After header takes precedence.
+	Backoff Backoff
+
+	// Methods sets the retry mode per HTTP method. By default GET, HEAD,
+	// OPTIONS, PUT, and DELETE are RetryIdempotent and other methods, such as
+	// POST and PATCH, are RetryUnsent.
+	Methods map[string]RetryMode
+	// Operations sets the retry mode per OpenAPI operation ID, such as
+	// "sendDocument", and takes precedence over Methods.
+	Operations map[string]RetryMode
 }
 
 // DefaultRetryPolicy returns a safe retry policy for API clients.
@@ -54,6 +71,14 @@ func (p RetryPolicy) normalize() RetryPolicy {
 	if p.MaxBackoff < p.InitialBackoff {
 		p.MaxBackoff = p.InitialBackoff
 	}
+	if p.Methods != nil {
+		methods := make(map[string]RetryMode, len(p.Methods))
+		for method, mode := range p.Methods {
+			methods[strings.ToUpper(strings.TrimSpace(method))] = mode
+		}
+		p.Methods = methods
+	}
+	p.Operations = maps.Clone(p.Operations)
 
 	return p
 }
@@ -113,6 +138,11 @@ func WithUserAgent(userAgent string) Option {
 // WithRetryPolicy sets a custom retry policy.
 func WithRetryPolicy(policy RetryPolicy) Option {
 	return func(cfg *clientConfig) error {
+		for op := range policy.Operations {
+			if !knownOperation(op) {
+				return fmt.Errorf("%w: %q", ErrUnknownOperation, op)
+			}
+		}
 		cfg.retryPolicy = policy.normalize()
 		return nil
 	}
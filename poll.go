@@ -0,0 +1,43 @@
+package pandadoc
+
+import (
+	"context"
+	"time"
+)
+
+// PollOptions tunes the wait helpers that poll asynchronous operations.
+//
+// By default polls are spaced using the client's RetryPolicy backoff and continue
+// until the operation finishes or ctx is done.
+type PollOptions struct {
+	// Interval, when positive, replaces the retry policy backoff with a fixed delay.
+	Interval time.Duration
+	// MaxAttempts, when positive, caps the number of status checks.
+	MaxAttempts int
+}
+
+// poll calls check until it reports done, returns an error, attempts run out, or ctx ends.
+func (c *Client) poll(ctx context.Context, opts *PollOptions, check func(ctx context.Context) (bool, error)) error {
+	var delay time.Duration
+	for attempt := 0; ; attempt++ {
+		done, err := check(ctx)
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if opts != nil && opts.MaxAttempts > 0 && attempt+1 >= opts.MaxAttempts {
+			return ErrPollAttemptsExhausted
+		}
+
+		delay = c.retryDelay(attempt, delay)
+		if opts != nil && opts.Interval > 0 {
+			delay = opts.Interval
+		}
+		c.logDebug("Polling again in %s (attempt %d)", delay, attempt+1)
+		if err = sleepWithContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,39 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// quotesService implements QuotesService.
+type quotesService struct {
+	client *Client
+}
+
+// Update replaces the sections, items, and adjustments of a document quote.
+func (s *quotesService) Update(ctx context.Context, documentID, quoteID string, reqBody *UpdateQuoteRequest) (*Quote, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	escapedQuoteID, err := escapePathParam(quoteID)
+	if err != nil {
+		return nil, fmt.Errorf("quote id: %w", err)
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out Quote
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPut,
+		path:        "/public/v1/documents/" + escapedID + "/quotes/" + escapedQuoteID,
+		requireAuth: true,
+		jsonBody:    reqBody,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,197 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestQuotesService_Update(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/public/v1/documents/doc1/quotes/q1" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		sections, _ := payload["sections"].([]any)
+		if payload["currency"] != "USD" || len(sections) != 1 {
+			t.Fatalf("unexpected quote payload: %+v", payload)
+		}
+		items, _ := sections[0].(map[string]any)["items"].([]any)
+		item, _ := items[0].(map[string]any)
+		discounts, _ := item["discounts"].(map[string]any)
+		if item["price"] != float64(99.5) || item["qty"] != float64(2) || discounts["promo"].(map[string]any)["type"] != "percent" {
+			t.Fatalf("unexpected item payload: %+v", item)
+		}
+		_, _ = io.WriteString(w, `{
+			"id":"q1","currency":"USD","total":"179.10",
+			"merge_rules":[{"id":"m1","enabled":true,"action":{"type":"show","section_id":"s1"},"condition":{"type":"and","field_name":"Deal.Stage","comparison":[{"type":"eq","value":"won"}]}}],
+			"sections":[{"id":"s1","name":"Licenses","total":"179.10","items":[{"id":"i1","name":"Seat","price":"99.50","qty":"2","discounts":{"promo":{"type":"percent","value":"10"}},"taxes":{"vat":{"type":"percent","value":"0"}}}],
+				"summary":{"total":"179.10","recurring_subtotal":[{"billing_cycle":"monthly","value":"179.10"}]}}],
+			"settings":{"selection_type":"custom"},
+			"summary":{"total":"179.10","total_discount":"19.90","discounts":{"promo":{"type":"percent","value":"10"}}}
+		}`)
+	})
+
+	quote, err := client.Quotes().Update(context.Background(), "doc1", "q1", &UpdateQuoteRequest{
+		Currency: "USD",
+		Sections: []QuoteSectionUpdate{{
+			ID: "s1",
+			Items: []QuoteItemUpdate{{
+				Name:      "Seat",
+				Price:     ptrFloat64(99.5),
+				Qty:       ptrInt(2),
+				Discounts: map[string]QuoteAdjustment{"promo": {Type: QuoteAdjustmentPercent, Value: 10}},
+			}},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	if quote.Total != "179.10" || len(quote.MergeRules) != 1 || quote.MergeRules[0].Condition.FieldName != "Deal.Stage" {
+		t.Fatalf("unexpected quote: %+v", quote)
+	}
+	item := quote.Sections[0].Items[0]
+	if item.Discounts["promo"].Value != "10" || item.Taxes["vat"].Type != "percent" || quote.Settings.SelectionType != QuoteSelectionCustom {
+		t.Fatalf("unexpected item: %+v", item)
+	}
+	if quote.Summary.TotalDiscount != "19.90" || quote.Sections[0].Summary.RecurringSubtotal[0].BillingCycle != "monthly" {
+		t.Fatalf("unexpected summary: %+v", quote.Summary)
+	}
+}
+
+func TestQuotesService_UpdateItemByID(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Fatalf("decode payload: %v", err)
+		}
+		sections, _ := payload["sections"].([]any)
+		items, _ := sections[0].(map[string]any)["items"].([]any)
+		item, _ := items[0].(map[string]any)
+		if _, ok := item["price"]; ok {
+			t.Fatalf("expected price to be omitted: %+v", item)
+		}
+		if _, ok := item["qty"]; ok {
+			t.Fatalf("expected qty to be omitted: %+v", item)
+		}
+		if item["id"] != "i1" {
+			t.Fatalf("unexpected item payload: %+v", item)
+		}
+		_, _ = io.WriteString(w, `{"id":"q1"}`)
+	})
+
+	_, err := client.Quotes().Update(context.Background(), "doc1", "q1", &UpdateQuoteRequest{
+		Sections: []QuoteSectionUpdate{{ID: "s1", Items: []QuoteItemUpdate{{ID: "i1"}}}},
+	})
+	if err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+}
+
+func TestQuoteUpdateFromCatalog(t *testing.T) {
+	t.Parallel()
+
+	seat := &ProductCatalogItemResponse{
+		UUID:                      "cat1",
+		Title:                     "Seat",
+		DefaultPriceConfiguration: RawJSON(`{"billing_type":"recurring","billing_cycle":"monthly","currency":"USD","price":25,"cost":5,"pricing_method":1,"tiers":[{"min_qty":1,"value":25},{"min_qty":10,"value":20}]}`),
+		Variants:                  RawJSON(`[{"sku":"SEAT-1","description":"Per-user seat"}]`),
+	}
+	setup := &ProductCatalogItemResponse{
+		UUID:                      "cat2",
+		Title:                     "Setup",
+		DefaultPriceConfiguration: RawJSON(`{"billing_type":"one_time","currency":"USD","price":500}`),
+	}
+
+	req, err := QuoteUpdateFromCatalog("s1", []CatalogQuoteLine{{Item: seat, Qty: 12}, {Item: setup, Qty: 1}})
+	if err != nil {
+		t.Fatalf("QuoteUpdateFromCatalog failed: %v", err)
+	}
+	if req.Currency != "USD" || len(req.Sections) != 1 || req.Sections[0].ID != "s1" || len(req.Sections[0].Items) != 2 {
+		t.Fatalf("unexpected request: %+v", req)
+	}
+	first := req.Sections[0].Items[0]
+	if first.SKU != "SEAT-1" || first.ReferenceID != "cat1" || *first.Price != 25 || *first.Cost != 5 || *first.Qty != 12 ||
+		first.BillingFrequency != BillingFrequencyMonthly || first.PriceSettings == nil || len(first.PriceSettings.Tiers) != 2 {
+		t.Fatalf("unexpected seat line: %+v", first)
+	}
+	second := req.Sections[0].Items[1]
+	if second.BillingFrequency != "" || second.PriceSettings != nil || second.SKU != "" || *second.Price != 500 {
+		t.Fatalf("unexpected setup line: %+v", second)
+	}
+
+	euro := &ProductCatalogItemResponse{UUID: "cat3", DefaultPriceConfiguration: RawJSON(`{"currency":"EUR","price":1}`)}
+	if _, err = QuoteUpdateFromCatalog("", []CatalogQuoteLine{{Item: seat, Qty: 1}, {Item: euro, Qty: 1}}); !errors.Is(err, ErrQuoteCurrencyMismatch) {
+		t.Fatalf("expected currency mismatch, got %v", err)
+	}
+	if _, err = QuoteUpdateFromCatalog("", nil); !errors.Is(err, ErrNoQuoteLines) {
+		t.Fatalf("expected no lines error, got %v", err)
+	}
+	if _, err = QuoteUpdateFromCatalog("", []CatalogQuoteLine{{Qty: 1}}); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil item error, got %v", err)
+	}
+	if req, err = QuoteUpdateFromCatalog("", []CatalogQuoteLine{{Item: setup}}); err != nil || *req.Sections[0].Items[0].Qty != 1 {
+		t.Fatalf("expected a zero Qty to quote one unit, got %+v, %v", req, err)
+	}
+	if _, _, err = QuoteItemFromCatalog(setup, 0); !errors.Is(err, ErrInvalidQuoteQuantity) {
+		t.Fatalf("expected invalid quantity error, got %v", err)
+	}
+	if _, _, err = QuoteItemFromCatalog(&ProductCatalogItemResponse{UUID: "x"}, 1); !errors.Is(err, ErrCatalogItemHasNoPrice) {
+		t.Fatalf("expected no price error, got %v", err)
+	}
+	if _, _, err = QuoteItemFromCatalog(&ProductCatalogItemResponse{DefaultPriceConfiguration: RawJSON(`[]`)}, 1); err == nil {
+		t.Fatalf("expected decode error")
+	}
+	if _, _, err = QuoteItemFromCatalog(&ProductCatalogItemResponse{DefaultPriceConfiguration: RawJSON(`{}`), Variants: RawJSON(`{}`)}, 1); err == nil {
+		t.Fatalf("expected variants decode error")
+	}
+}
+
+func TestDocumentDetailsResponse_PricingDetails(t *testing.T) {
+	t.Parallel()
+
+	details := &DocumentDetailsResponse{Pricing: RawJSON(`{"quotes":[{"id":"q1","currency":"USD","sections":[{"id":"s1","items":[{"name":"Seat"}]}]}],"total":"10.00"}`)}
+	pricing, err := details.PricingDetails()
+	if err != nil || len(pricing.Quotes) != 1 || pricing.Quotes[0].Sections[0].Items[0].Name != "Seat" || pricing.Total != "10.00" {
+		t.Fatalf("unexpected pricing: %v %+v", err, pricing)
+	}
+
+	empty, err := (&DocumentDetailsResponse{}).PricingDetails()
+	if err != nil || empty == nil || len(empty.Quotes) != 0 {
+		t.Fatalf("unexpected empty pricing: %v %+v", err, empty)
+	}
+	if _, err = (&DocumentDetailsResponse{Pricing: RawJSON(`"bad"`)}).PricingDetails(); err == nil {
+		t.Fatalf("expected decode error")
+	}
+}
+
+func TestQuotesService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	if _, err := client.Quotes().Update(ctx, "", "q1", &UpdateQuoteRequest{}); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty document id error, got %v", err)
+	}
+	if _, err := client.Quotes().Update(ctx, "doc1", "", &UpdateQuoteRequest{}); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty quote id error, got %v", err)
+	}
+	if _, err := client.Quotes().Update(ctx, "doc1", "q1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+}
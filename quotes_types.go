@@ -0,0 +1,367 @@
+package pandadoc
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// QuoteSelectionType controls how items or sections in a quote can be selected.
+type QuoteSelectionType string
+
+// Supported quote selection types.
+const (
+	// QuoteSelectionCustom configures selection per item or section.
+	QuoteSelectionCustom QuoteSelectionType = "custom"
+	// QuoteSelectionSingle allows one option to be selected.
+	QuoteSelectionSingle QuoteSelectionType = "single"
+	// QuoteSelectionMultiple allows several options to be selected.
+	QuoteSelectionMultiple QuoteSelectionType = "multiple"
+)
+
+// QuoteAdjustmentType is the kind of a quote discount, fee, or tax.
+type QuoteAdjustmentType string
+
+// Supported quote adjustment types.
+const (
+	// QuoteAdjustmentPercent is a percentage of the amount.
+	QuoteAdjustmentPercent QuoteAdjustmentType = "percent"
+	// QuoteAdjustmentFlat is a fixed amount.
+	QuoteAdjustmentFlat QuoteAdjustmentType = "flat"
+)
+
+// BillingFrequency is the recurrence of a quote line item.
+type BillingFrequency string
+
+// Supported billing frequencies.
+const (
+	// BillingFrequencyWeekly bills every week.
+	BillingFrequencyWeekly BillingFrequency = "weekly"
+	// BillingFrequencyMonthly bills every month.
+	BillingFrequencyMonthly BillingFrequency = "monthly"
+	// BillingFrequencyQuarterly bills every quarter.
+	BillingFrequencyQuarterly BillingFrequency = "quarterly"
+	// BillingFrequencySemiannually bills twice a year; the value keeps the API's own spelling.
+	BillingFrequencySemiannually BillingFrequency = "semiannualy"
+	// BillingFrequencyAnnually bills every year.
+	BillingFrequencyAnnually BillingFrequency = "annually"
+)
+
+// QuoteSettings are quote-level selection settings.
+type QuoteSettings struct {
+	SelectionType QuoteSelectionType `json:"selection_type,omitempty"`
+}
+
+// QuoteSectionSettings are section-level selection settings.
+type QuoteSectionSettings struct {
+	Optional      bool               `json:"optional,omitempty"`
+	Selected      bool               `json:"selected,omitempty"`
+	SelectionType QuoteSelectionType `json:"selection_type,omitempty"`
+}
+
+// QuoteItemOptions are selection options for a quote line item.
+type QuoteItemOptions struct {
+	Optional    *bool `json:"optional,omitempty"`
+	QtyEditable *bool `json:"qty_editable,omitempty"`
+	Selected    *bool `json:"selected,omitempty"`
+}
+
+// QuoteRecurringSubtotal is a subtotal for one billing cycle.
+type QuoteRecurringSubtotal struct {
+	BillingCycle string `json:"billing_cycle,omitempty"`
+	Value        string `json:"value,omitempty"`
+}
+
+// QuoteColumn describes a column shown in a quote section.
+type QuoteColumn struct {
+	Name      string `json:"name,omitempty"`
+	Header    string `json:"header,omitempty"`
+	Hidden    string `json:"hidden,omitempty"`
+	MergeName string `json:"merge_name,omitempty"`
+}
+
+// QuoteItem is a line item in a quote section as returned by the API.
+//
+// Numeric values are returned as decimal strings.
+type QuoteItem struct {
+	ID               string                       `json:"id,omitempty"`
+	SKU              string                       `json:"sku,omitempty"`
+	Name             string                       `json:"name,omitempty"`
+	Description      string                       `json:"description,omitempty"`
+	Type             string                       `json:"type,omitempty"`
+	ReferenceType    string                       `json:"reference_type,omitempty"`
+	PricingMethod    string                       `json:"pricing_method,omitempty"`
+	BillingFrequency string                       `json:"billing_frequency,omitempty"`
+	ContractTerm     string                       `json:"contract_term,omitempty"`
+	Price            string                       `json:"price,omitempty"`
+	Cost             string                       `json:"cost,omitempty"`
+	Qty              string                       `json:"qty,omitempty"`
+	Total            string                       `json:"total,omitempty"`
+	OverallTotal     string                       `json:"overall_total,omitempty"`
+	Options          *QuoteItemOptions            `json:"options,omitempty"`
+	Discounts        map[string]PricingAdjustment `json:"discounts,omitempty"`
+	Fees             map[string]PricingAdjustment `json:"fees,omitempty"`
+	Taxes            map[string]PricingAdjustment `json:"taxes,omitempty"`
+	Multipliers      map[string]string            `json:"multipliers,omitempty"`
+	CustomColumns    map[string]string            `json:"custom_columns,omitempty"`
+	ExternalColumns  map[string]string            `json:"external_columns,omitempty"`
+}
+
+// QuoteSectionSummary contains totals for a quote section.
+type QuoteSectionSummary struct {
+	Total             string                   `json:"total,omitempty"`
+	Subtotal          string                   `json:"subtotal,omitempty"`
+	OneTimeSubtotal   string                   `json:"one_time_subtotal,omitempty"`
+	RecurringSubtotal []QuoteRecurringSubtotal `json:"recurring_subtotal,omitempty"`
+	TotalQty          string                   `json:"total_qty,omitempty"`
+	TotalSectionValue string                   `json:"total_section_value,omitempty"`
+	CustomFields      map[string]string        `json:"custom_fields,omitempty"`
+	Discounts         RawJSON                  `json:"discounts,omitempty"`
+	Fees              RawJSON                  `json:"fees,omitempty"`
+	Taxes             RawJSON                  `json:"taxes,omitempty"`
+}
+
+// QuoteSection is a group of line items in a quote.
+type QuoteSection struct {
+	ID       string                `json:"id,omitempty"`
+	Name     string                `json:"name,omitempty"`
+	Total    string                `json:"total,omitempty"`
+	Columns  []QuoteColumn         `json:"columns,omitempty"`
+	Items    []QuoteItem           `json:"items,omitempty"`
+	Settings *QuoteSectionSettings `json:"settings,omitempty"`
+	Summary  *QuoteSectionSummary  `json:"summary,omitempty"`
+}
+
+// QuoteSummary contains quote-level totals.
+type QuoteSummary struct {
+	Total              string                       `json:"total,omitempty"`
+	Subtotal           string                       `json:"subtotal,omitempty"`
+	OneTimeSubtotal    string                       `json:"one_time_subtotal,omitempty"`
+	RecurringSubtotal  []QuoteRecurringSubtotal     `json:"recurring_subtotal,omitempty"`
+	TotalContractValue string                       `json:"total_contract_value,omitempty"`
+	TotalDiscount      string                       `json:"total_discount,omitempty"`
+	TotalFee           string                       `json:"total_fee,omitempty"`
+	TotalQty           string                       `json:"total_qty,omitempty"`
+	TotalSavings       string                       `json:"total_savings,omitempty"`
+	TotalTax           string                       `json:"total_tax,omitempty"`
+	CustomFields       map[string]string            `json:"custom_fields,omitempty"`
+	Discounts          map[string]PricingAdjustment `json:"discounts,omitempty"`
+	Fees               RawJSON                      `json:"fees,omitempty"`
+	Taxes              RawJSON                      `json:"taxes,omitempty"`
+}
+
+// QuoteMergeRuleAction is what a merge rule does when its condition matches.
+type QuoteMergeRuleAction struct {
+	Type      string `json:"type,omitempty"`
+	SectionID string `json:"section_id,omitempty"`
+}
+
+// QuoteMergeRuleComparison is one comparison in a merge rule condition.
+type QuoteMergeRuleComparison struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// QuoteMergeRuleCondition is the condition that triggers a merge rule.
+type QuoteMergeRuleCondition struct {
+	Type       string                     `json:"type,omitempty"`
+	FieldName  string                     `json:"field_name,omitempty"`
+	Comparison []QuoteMergeRuleComparison `json:"comparison,omitempty"`
+}
+
+// QuoteMergeRule shows or hides quote sections based on merge field values.
+type QuoteMergeRule struct {
+	ID        string                   `json:"id,omitempty"`
+	Enabled   bool                     `json:"enabled"`
+	Action    *QuoteMergeRuleAction    `json:"action,omitempty"`
+	Condition *QuoteMergeRuleCondition `json:"condition,omitempty"`
+}
+
+// Quote is a document quote as returned by the API.
+type Quote struct {
+	ID         string           `json:"id,omitempty"`
+	Currency   string           `json:"currency,omitempty"`
+	Total      string           `json:"total,omitempty"`
+	MergeRules []QuoteMergeRule `json:"merge_rules,omitempty"`
+	Sections   []QuoteSection   `json:"sections,omitempty"`
+	Settings   *QuoteSettings   `json:"settings,omitempty"`
+	Summary    *QuoteSummary    `json:"summary,omitempty"`
+}
+
+// QuoteAdjustment is a discount, fee, or tax in a quote update.
+type QuoteAdjustment struct {
+	Type  QuoteAdjustmentType `json:"type"`
+	Value float64             `json:"value"`
+}
+
+// QuotePriceTier is a quantity threshold in tiered pricing.
+type QuotePriceTier struct {
+	MinQty int     `json:"min_qty"`
+	Value  float64 `json:"value"`
+}
+
+// QuotePriceSettings configures tiered pricing for a line item.
+type QuotePriceSettings struct {
+	BaseValue *float64         `json:"base_value"`
+	Tiers     []QuotePriceTier `json:"tiers"`
+}
+
+// QuoteItemUpdate is a line item in a quote update.
+//
+// Price and Qty are pointers so an update that targets an existing item by ID
+// leaves them unchanged when nil.
+type QuoteItemUpdate struct {
+	ID               string                     `json:"id,omitempty"`
+	SKU              string                     `json:"sku,omitempty"`
+	Name             string                     `json:"name,omitempty"`
+	Description      string                     `json:"description,omitempty"`
+	ReferenceID      string                     `json:"reference_id,omitempty"`
+	Price            *float64                   `json:"price,omitempty"`
+	Cost             *float64                   `json:"cost,omitempty"`
+	Qty              *int                       `json:"qty,omitempty"`
+	BillingFrequency BillingFrequency           `json:"billing_frequency,omitempty"`
+	ContractTerm     *int                       `json:"contract_term,omitempty"`
+	Options          *QuoteItemOptions          `json:"options,omitempty"`
+	PriceSettings    *QuotePriceSettings        `json:"price_settings,omitempty"`
+	Discounts        map[string]QuoteAdjustment `json:"discounts,omitempty"`
+	Fees             map[string]QuoteAdjustment `json:"fees,omitempty"`
+	Taxes            map[string]QuoteAdjustment `json:"taxes,omitempty"`
+	Multipliers      map[string]float64         `json:"multipliers,omitempty"`
+	CustomColumns    map[string]string          `json:"custom_columns,omitempty"`
+	ExternalColumns  map[string]string          `json:"external_columns,omitempty"`
+	TextColumns      map[string]string          `json:"text_columns,omitempty"`
+}
+
+// QuoteSummaryUpdate sets quote or section level adjustments.
+type QuoteSummaryUpdate struct {
+	CustomColumns map[string]string          `json:"custom_columns,omitempty"`
+	Discounts     map[string]QuoteAdjustment `json:"discounts,omitempty"`
+	Fees          map[string]QuoteAdjustment `json:"fees,omitempty"`
+	Taxes         map[string]QuoteAdjustment `json:"taxes,omitempty"`
+}
+
+// QuoteSectionUpdate is a section in a quote update.
+type QuoteSectionUpdate struct {
+	ID       string                `json:"id,omitempty"`
+	Name     string                `json:"name,omitempty"`
+	Items    []QuoteItemUpdate     `json:"items,omitempty"`
+	Settings *QuoteSectionSettings `json:"settings,omitempty"`
+	Summary  *QuoteSummaryUpdate   `json:"summary,omitempty"`
+}
+
+// UpdateQuoteRequest replaces the contents of a document quote.
+type UpdateQuoteRequest struct {
+	Currency string               `json:"currency,omitempty"`
+	Sections []QuoteSectionUpdate `json:"sections,omitempty"`
+	Settings *QuoteSettings       `json:"settings,omitempty"`
+	Summary  *QuoteSummaryUpdate  `json:"summary,omitempty"`
+}
+
+// CatalogQuoteLine pairs a product catalog item with the quantity to quote.
+//
+// A zero Qty quotes one unit.
+type CatalogQuoteLine struct {
+	Item *ProductCatalogItemResponse
+	Qty  int
+}
+
+// catalogPriceConfiguration is the price shape inside catalog item responses.
+type catalogPriceConfiguration struct {
+	BillingType  string   `json:"billing_type"`
+	BillingCycle string   `json:"billing_cycle"`
+	Currency     string   `json:"currency"`
+	Price        *float64 `json:"price"`
+	Cost         *float64 `json:"cost"`
+	Tiers        []struct {
+		MinQty float64 `json:"min_qty"`
+		Value  float64 `json:"value"`
+	} `json:"tiers"`
+}
+
+// catalogVariant is the subset of catalog variant fields used for quote lines.
+type catalogVariant struct {
+	SKU         string `json:"sku"`
+	Description string `json:"description"`
+}
+
+// QuoteItemFromCatalog converts a product catalog item into a quote line item.
+//
+// Price, cost, tiers, and billing frequency come from the item's default price
+// configuration; SKU and description come from its first variant. The catalog
+// item UUID is kept as the line's reference ID. The item's currency is returned
+// alongside the line so callers can set the quote currency. qty must be positive.
+func QuoteItemFromCatalog(item *ProductCatalogItemResponse, qty int) (QuoteItemUpdate, string, error) {
+	if item == nil {
+		return QuoteItemUpdate{}, "", ErrNilRequest
+	}
+	if qty <= 0 {
+		return QuoteItemUpdate{}, "", fmt.Errorf("%w: %d", ErrInvalidQuoteQuantity, qty)
+	}
+	if len(item.DefaultPriceConfiguration) == 0 {
+		return QuoteItemUpdate{}, "", fmt.Errorf("%w: %s", ErrCatalogItemHasNoPrice, item.UUID)
+	}
+
+	var price catalogPriceConfiguration
+	if err := json.Unmarshal(item.DefaultPriceConfiguration, &price); err != nil {
+		return QuoteItemUpdate{}, "", fmt.Errorf("decode price configuration: %w", err)
+	}
+	var variants []catalogVariant
+	if len(item.Variants) > 0 {
+		if err := json.Unmarshal(item.Variants, &variants); err != nil {
+			return QuoteItemUpdate{}, "", fmt.Errorf("decode variants: %w", err)
+		}
+	}
+
+	line := QuoteItemUpdate{
+		Name:        item.Title,
+		ReferenceID: item.UUID,
+		Price:       price.Price,
+		Cost:        price.Cost,
+		Qty:         &qty,
+	}
+	if len(variants) > 0 {
+		line.SKU = variants[0].SKU
+		line.Description = variants[0].Description
+	}
+	if price.BillingType == string(ProductCatalogBillingTypeRecurring) {
+		line.BillingFrequency = BillingFrequency(price.BillingCycle)
+	}
+	if len(price.Tiers) > 0 {
+		tiers := make([]QuotePriceTier, 0, len(price.Tiers))
+		for _, t := range price.Tiers {
+			tiers = append(tiers, QuotePriceTier{MinQty: int(t.MinQty), Value: t.Value})
+		}
+		line.PriceSettings = &QuotePriceSettings{BaseValue: price.Price, Tiers: tiers}
+	}
+	return line, price.Currency, nil
+}
+
+// QuoteUpdateFromCatalog builds a quote update with one section holding the given catalog lines.
+//
+// All lines must share a currency, which becomes the quote currency.
+func QuoteUpdateFromCatalog(sectionID string, lines []CatalogQuoteLine) (*UpdateQuoteRequest, error) {
+	if len(lines) == 0 {
+		return nil, ErrNoQuoteLines
+	}
+
+	section := QuoteSectionUpdate{ID: sectionID, Items: make([]QuoteItemUpdate, 0, len(lines))}
+	currency := ""
+	for i, l := range lines {
+		qty := l.Qty
+		if qty == 0 {
+			qty = 1
+		}
+		item, itemCurrency, err := QuoteItemFromCatalog(l.Item, qty)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i, err)
+		}
+		switch {
+		case currency == "":
+			currency = itemCurrency
+		case itemCurrency != "" && itemCurrency != currency:
+			return nil, fmt.Errorf("line %d: %w: %s and %s", i, ErrQuoteCurrencyMismatch, currency, itemCurrency)
+		}
+		section.Items = append(section.Items, item)
+	}
+
+	return &UpdateQuoteRequest{Currency: currency, Sections: []QuoteSectionUpdate{section}}, nil
+}
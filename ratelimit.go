@@ -0,0 +1,269 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mrz1836/go-pandadoc/internal/spec"
+)
+
+var errInvalidRateLimit = fmt.Errorf("rate limit requests, period, and burst must be >= 0")
+
+// RateLimit configures one token bucket.
+//
+// A zero Requests value means the bucket does not limit on its own, but it
+// still pauses when PandaDoc reports that a limit was reached.
+type RateLimit struct {
+	// Requests is the number of requests allowed per Period.
+	Requests int
+	// Period is the refill window; it defaults to one minute.
+	Period time.Duration
+	// Burst is the bucket capacity; it defaults to Requests.
+	Burst int
+}
+
+// RateLimiterConfig configures client-side rate limiting.
+//
+// Each operation gets its own bucket, so a busy list endpoint never starves
+// create, send, or download calls. The SDK ships no PandaDoc limits: only the
+// limits set here are enforced.
+type RateLimiterConfig struct {
+	// Default applies to every operation without its own entry. Its zero
+	// value enforces no limit.
+	Default RateLimit
+	// Operations overrides Default per OpenAPI operation ID, such as
+	// "createDocument", "sendDocument", or "downloadDocument".
+	Operations map[string]RateLimit
+}
+
+// WithRateLimiter throttles requests with a token bucket per PandaDoc operation.
+//
+// Requests wait for a token before each attempt, blocking until one is available
+// or the request context is done. Buckets also pause on Retry-After and drain to
+// the X-RateLimit-Remaining / RateLimit-Remaining value the API reports.
+// Pre-signed download URLs outside the API are not limited.
+//
+// No PandaDoc limits are built in. With a zero Default, operations without an
+// entry in Operations are never throttled ahead of time; they only pause after
+// the API reports a limit. Set Default and Operations to the limits of your
+// plan to throttle proactively.
+func WithRateLimiter(config RateLimiterConfig) Option {
+	return func(cfg *clientConfig) error {
+		if !config.Default.valid() {
+			return errInvalidRateLimit
+		}
+		for op, limit := range config.Operations {
+			if !knownOperation(op) {
+				return fmt.Errorf("%w: %q", ErrUnknownOperation, op)
+			}
+			if !limit.valid() {
+				return fmt.Errorf("%q: %w", op, errInvalidRateLimit)
+			}
+		}
+		cfg.rateLimiter = newRateLimiter(config)
+		return nil
+	}
+}
+
+func (l RateLimit) valid() bool {
+	return l.Requests >= 0 && l.Period >= 0 && l.Burst >= 0
+}
+
+func knownOperation(operationID string) bool {
+	for _, op := range spec.CoveredOperations {
+		if op.OperationID == operationID {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimiter holds lazily created buckets keyed by operation ID.
+type rateLimiter struct {
+	config RateLimiterConfig
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+}
+
+func newRateLimiter(config RateLimiterConfig) *rateLimiter {
+	operations := make(map[string]RateLimit, len(config.Operations))
+	for op, limit := range config.Operations {
+		operations[op] = limit
+	}
+	config.Operations = operations
+
+	return &rateLimiter{config: config, buckets: make(map[string]*tokenBucket)}
+}
+
+// wait blocks until the operation's bucket grants a token and returns the time spent waiting.
+func (l *rateLimiter) wait(ctx context.Context, operationID string) (time.Duration, error) {
+	if l == nil || operationID == "" {
+		return 0, nil
+	}
+
+	b := l.bucket(operationID)
+	var waited time.Duration
+	for {
+		d := b.reserve(time.Now())
+		if d <= 0 {
+			return waited, nil
+		}
+		if err := sleepWithContext(ctx, d); err != nil {
+			return waited, err
+		}
+		waited += d
+	}
+}
+
+// observe adapts the operation's bucket to the limits reported by a response.
+func (l *rateLimiter) observe(operationID string, resp *http.Response) {
+	if l == nil || operationID == "" || resp == nil {
+		return
+	}
+
+	now := time.Now()
+	throttled := resp.StatusCode == http.StatusTooManyRequests
+	var pauseUntil time.Time
+	if throttled || resp.StatusCode == http.StatusServiceUnavailable {
+		if d, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			pauseUntil = now.Add(d)
+		}
+	}
+
+	remaining, hasRemaining := parseRateLimitRemaining(resp.Header)
+	if hasRemaining && remaining == 0 {
+		if reset, ok := parseRateLimitReset(resp.Header, now); ok && reset.After(pauseUntil) {
+			pauseUntil = reset
+		}
+	}
+	if throttled && !hasRemaining {
+		remaining, hasRemaining = 0, true
+	}
+
+	l.bucket(operationID).adapt(now, pauseUntil, remaining, hasRemaining)
+}
+
+func (l *rateLimiter) bucket(operationID string) *tokenBucket {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	b, ok := l.buckets[operationID]
+	if !ok {
+		limit, found := l.config.Operations[operationID]
+		if !found {
+			limit = l.config.Default
+		}
+		b = newTokenBucket(limit)
+		l.buckets[operationID] = b
+	}
+	return b
+}
+
+// tokenBucket is a refilling bucket; a zero rate only applies server-driven pauses.
+type tokenBucket struct {
+	mu          sync.Mutex
+	rate        float64 // tokens per second
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+func newTokenBucket(limit RateLimit) *tokenBucket {
+	b := &tokenBucket{last: time.Now()}
+	if limit.Requests == 0 {
+		return b
+	}
+
+	period := limit.Period
+	if period == 0 {
+		period = time.Minute
+	}
+	burst := limit.Burst
+	if burst == 0 {
+		burst = limit.Requests
+	}
+
+	b.rate = float64(limit.Requests) / period.Seconds()
+	b.burst = float64(burst)
+	b.tokens = b.burst
+	return b
+}
+
+// reserve takes a token and returns zero, or returns how long to wait before trying again.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.Before(b.pausedUntil) {
+		return b.pausedUntil.Sub(now)
+	}
+	if b.rate == 0 {
+		return 0
+	}
+
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
+
+func (b *tokenBucket) adapt(now, pauseUntil time.Time, remaining int, hasRemaining bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if pauseUntil.After(b.pausedUntil) {
+		b.pausedUntil = pauseUntil
+	}
+	if b.rate == 0 || !hasRemaining {
+		return
+	}
+
+	b.refill(now)
+	if float64(remaining) < b.tokens {
+		b.tokens = float64(remaining)
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last).Seconds(); elapsed > 0 {
+		b.tokens = min(b.burst, b.tokens+elapsed*b.rate)
+	}
+	b.last = now
+}
+
+func parseRateLimitRemaining(h http.Header) (int, bool) {
+	remaining, err := strconv.Atoi(headerFirst(h, "X-RateLimit-Remaining", "RateLimit-Remaining"))
+	if err != nil || remaining < 0 {
+		return 0, false
+	}
+	return remaining, true
+}
+
+// maxRateLimitResetDelta is the longest reset read as seconds from now; larger
+// values are Unix timestamps.
+const maxRateLimitResetDelta = 24 * time.Hour
+
+// parseRateLimitReset reads a reset header given either as seconds from now or
+// as a future Unix timestamp, ignoring values that are neither.
+func parseRateLimitReset(h http.Header, now time.Time) (time.Time, bool) {
+	value := strings.TrimSpace(headerFirst(h, "X-RateLimit-Reset", "RateLimit-Reset"))
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	switch {
+	case err != nil || seconds < 0:
+		return time.Time{}, false
+	case seconds <= int64(maxRateLimitResetDelta/time.Second):
+		return now.Add(time.Duration(seconds) * time.Second), true
+	case seconds > now.Unix():
+		return time.Unix(seconds, 0), true
+	}
+	return time.Time{}, false
+}
@@ -0,0 +1,178 @@
+package pandadoc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithRateLimiter_PerOperationBuckets(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		calls.Add(1)
+		_, _ = io.WriteString(w, `{"results":[]}`)
+	}, WithRateLimiter(RateLimiterConfig{
+		Operations: map[string]RateLimit{"listDocuments": {Requests: 1, Period: 300 * time.Millisecond}},
+	}))
+
+	if _, err := client.Documents().List(context.Background(), nil); err != nil {
+		t.Fatalf("first List failed: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if _, err := client.Documents().List(ctx, nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline while waiting for a token, got %v", err)
+	}
+	if calls.Load() != 1 {
+		t.Fatalf("expected throttled request not to reach the server, got %d calls", calls.Load())
+	}
+
+	if _, err := client.Contacts().List(context.Background(), nil); err != nil {
+		t.Fatalf("expected other operations to use their own bucket, got %v", err)
+	}
+
+	start := time.Now()
+	if _, err := client.Documents().List(context.Background(), nil); err != nil {
+		t.Fatalf("third List failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 200*time.Millisecond {
+		t.Fatalf("expected List to wait for a refill, waited %v", elapsed)
+	}
+}
+
+func TestWithRateLimiter_AdaptsToResponseHeaders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status int
+		header http.Header
+	}{
+		{name: "retry after", status: http.StatusTooManyRequests, header: http.Header{"Retry-After": {"1"}}},
+		{name: "remaining and reset", status: http.StatusOK, header: http.Header{"X-Ratelimit-Remaining": {"0"}, "X-Ratelimit-Reset": {"1"}}},
+		{name: "draft reset", status: http.StatusOK, header: http.Header{
+			"Ratelimit-Remaining": {"0"},
+			"Ratelimit-Reset":     {strconv.FormatInt(time.Now().Add(2*time.Second).Unix(), 10)},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls atomic.Int32
+			client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+				calls.Add(1)
+				for k, v := range tt.header {
+					w.Header()[k] = v
+				}
+				w.WriteHeader(tt.status)
+				_, _ = io.WriteString(w, `{"results":[]}`)
+			}, WithRateLimiter(RateLimiterConfig{Default: RateLimit{Requests: 100}}))
+
+			_, _ = client.Documents().List(context.Background(), nil)
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			if _, err := client.Documents().List(ctx, nil); !errors.Is(err, context.DeadlineExceeded) {
+				t.Fatalf("expected the bucket to pause, got %v", err)
+			}
+			if calls.Load() != 1 {
+				t.Fatalf("expected paused request not to reach the server, got %d calls", calls.Load())
+			}
+		})
+	}
+}
+
+func TestWithRateLimiter_Validation(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClientWithAPIKey("key", WithRateLimiter(RateLimiterConfig{
+		Operations: map[string]RateLimit{"createDocumnet": {Requests: 1}},
+	}))
+	if !errors.Is(err, ErrUnknownOperation) {
+		t.Fatalf("expected ErrUnknownOperation, got %v", err)
+	}
+
+	if _, err = NewClientWithAPIKey("key", WithRateLimiter(RateLimiterConfig{Default: RateLimit{Requests: -1}})); err == nil {
+		t.Fatalf("expected error for negative default limit")
+	}
+	if _, err = NewClientWithAPIKey("key", WithRateLimiter(RateLimiterConfig{
+		Operations: map[string]RateLimit{"sendDocument": {Requests: 1, Period: -time.Second}},
+	})); err == nil {
+		t.Fatalf("expected error for negative period")
+	}
+}
+
+func TestParseRateLimitReset(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1_750_000_000, 0)
+	tests := []struct {
+		value string
+		want  time.Time
+		ok    bool
+	}{
+		{value: "30", want: now.Add(30 * time.Second), ok: true},
+		{value: "86400", want: now.Add(24 * time.Hour), ok: true},
+		{value: "1750000060", want: now.Add(time.Minute), ok: true},
+		{value: "86401"},
+		{value: "1749999999"},
+		{value: "-1"},
+		{value: "soon"},
+		{value: ""},
+	}
+	for _, tt := range tests {
+		got, ok := parseRateLimitReset(http.Header{"X-Ratelimit-Reset": {tt.value}}, now)
+		if ok != tt.ok || !got.Equal(tt.want) {
+			t.Fatalf("%q: expected %v %v, got %v %v", tt.value, tt.want, tt.ok, got, ok)
+		}
+	}
+}
+
+func TestTokenBucket_ReserveAndAdapt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	b := newTokenBucket(RateLimit{Requests: 2, Period: time.Second})
+	b.last = now
+
+	if b.reserve(now) != 0 || b.reserve(now) != 0 {
+		t.Fatalf("expected burst of two tokens")
+	}
+	if d := b.reserve(now); d != 500*time.Millisecond {
+		t.Fatalf("expected 500ms until the next token, got %v", d)
+	}
+	if d := b.reserve(now.Add(500 * time.Millisecond)); d != 0 {
+		t.Fatalf("expected a refilled token, got wait %v", d)
+	}
+
+	later := now.Add(10 * time.Second)
+	b.adapt(later, time.Time{}, 1, true)
+	if b.reserve(later) != 0 {
+		t.Fatalf("expected the reported remaining token")
+	}
+	if d := b.reserve(later); d <= 0 {
+		t.Fatalf("expected tokens capped by the reported remaining count")
+	}
+
+	b.adapt(later, later.Add(time.Minute), 0, false)
+	if d := b.reserve(later.Add(time.Second)); d != 59*time.Second {
+		t.Fatalf("expected pause until reset, got %v", d)
+	}
+
+	unlimited := newTokenBucket(RateLimit{})
+	for range 1000 {
+		if d := unlimited.reserve(now); d != 0 {
+			t.Fatalf("expected unlimited bucket not to wait, got %v", d)
+		}
+	}
+}
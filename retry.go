@@ -0,0 +1,234 @@
+package pandadoc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// IdempotencyKeyHeader is the header that carries caller-supplied idempotency keys.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// RetryMode controls when a request may be retried.
+type RetryMode int
+
+const (
+	// RetryDefault defers to the per-operation, per-method, then built-in rules.
+	RetryDefault RetryMode = iota
+	// RetryIdempotent retries transport errors and retryable statuses.
+	RetryIdempotent
+	// RetryUnsent retries only when the request cannot have been processed:
+	// connection failures before the request was written, and 429 responses.
+	RetryUnsent
+	// RetryNever disables retries.
+	RetryNever
+)
+
+// String returns the mode name.
+func (m RetryMode) String() string {
+	switch m {
+	case RetryDefault:
+		return "default"
+	case RetryIdempotent:
+		return "idempotent"
+	case RetryUnsent:
+		return "unsent"
+	case RetryNever:
+		return "never"
+	}
+	return fmt.Sprintf("RetryMode(%d)", int(m))
+}
+
+// RetryDecision records whether a physical attempt will be retried.
+type RetryDecision struct {
+	// Retry reports whether another attempt follows.
+	Retry bool
+	// Reason describes the decision, such as "status 503" or
+	// "transport error: POST request may have been sent". It is empty when the
+	// attempt produced a final response.
+	Reason string
+}
+
+type idempotencyKeyContextKey struct{}
+
+// ContextWithIdempotencyKey returns a context whose SDK calls send key in the
+// Idempotency-Key header on every attempt.
+//
+// Keyed calls are retried like idempotent ones unless their mode is RetryNever.
+// Use a fresh key for each logical operation.
+func ContextWithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, strings.TrimSpace(key))
+}
+
+func idempotencyKeyFromContext(ctx context.Context) string {
+	key, _ := ctx.Value(idempotencyKeyContextKey{}).(string)
+	return key
+}
+
+// mode resolves the retry mode for a request; GET, HEAD, OPTIONS, PUT, and
+// DELETE are idempotent by default and other methods use RetryUnsent.
+func (p RetryPolicy) mode(method, operationID string) RetryMode {
+	if m := p.Operations[operationID]; m != RetryDefault {
+		return m
+	}
+	if m := p.Methods[method]; m != RetryDefault {
+		return m
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return RetryIdempotent
+	}
+	return RetryUnsent
+}
+
+// retryMode returns the effective mode of a call, honouring middleware changes and idempotency keys.
+func (c *Client) retryMode(call *Call) RetryMode {
+	mode := call.RetryMode
+	if mode == RetryDefault {
+		mode = c.retryPolicy.mode(call.Method, call.OperationID)
+	}
+	if mode == RetryUnsent && call.IdempotencyKey != "" {
+		return RetryIdempotent
+	}
+	return mode
+}
+
+// decideRetry decides whether an attempt is retried, given whether its request
+// may have been sent. Attempts whose context is done are never retried.
+func (c *Client) decideRetry(ctx context.Context, p *preparedRequest, call *Call, attempt int, resp *http.Response, err error, sent bool) *RetryDecision {
+	outcome := "transport error"
+	retryable := c.shouldRetryOnError(attempt, err)
+	if err == nil {
+		if !c.retryableStatus(resp.StatusCode) {
+			return &RetryDecision{}
+		}
+		outcome = fmt.Sprintf("status %d", resp.StatusCode)
+		retryable = c.shouldRetryOnStatus(attempt, resp)
+	}
+
+	switch {
+	case ctx.Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return &RetryDecision{Reason: outcome + ": context done"}
+	case !retryable:
+		return &RetryDecision{Reason: outcome + ": retries exhausted"}
+	case !p.body.replayable():
+		return &RetryDecision{Reason: outcome + ": upload cannot be rewound"}
+	}
+
+	switch c.retryMode(call) {
+	case RetryNever:
+		return &RetryDecision{Reason: outcome + ": retries disabled"}
+	case RetryUnsent:
+		if (err != nil && sent) || (err == nil && resp.StatusCode != http.StatusTooManyRequests) {
+			return &RetryDecision{Reason: fmt.Sprintf("%s: %s request may have been sent", outcome, call.Method)}
+		}
+	case RetryDefault, RetryIdempotent:
+	}
+
+	if !c.retryBudget.withdraw() {
+		return &RetryDecision{Reason: outcome + ": retry budget exhausted"}
+	}
+	return &RetryDecision{Retry: true, Reason: outcome}
+}
+
+// requestUnsent reports whether a failed attempt cannot have reached the server:
+// the connection failed, or it was obtained but no headers were written.
+func requestUnsent(err error, gotConn, wroteHeaders bool) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	return gotConn && !wroteHeaders
+}
+
+var errInvalidRetryBudget = fmt.Errorf("retry budget ratio, min retries, and window must be >= 0")
+
+// RetryBudget caps retries client-wide as a fraction of recent requests.
+//
+// During an outage every call fails, and without a budget each one is sent
+// MaxRetries+1 times. With a budget, retries stop once they exceed
+// MinRetries plus Ratio times the logical calls made within Window.
+type RetryBudget struct {
+	// Ratio is the retries allowed per logical call, such as 0.1 for 10%.
+	Ratio float64
+	// MinRetries are allowed per Window regardless of traffic.
+	MinRetries int
+	// Window is the accounting period; it defaults to 10 seconds.
+	Window time.Duration
+}
+
+// WithRetryBudget limits retries across all calls made by the client.
+func WithRetryBudget(budget RetryBudget) Option {
+	return func(cfg *clientConfig) error {
+		if budget.Ratio < 0 || budget.MinRetries < 0 || budget.Window < 0 {
+			return errInvalidRetryBudget
+		}
+		if budget.Window == 0 {
+			budget.Window = 10 * time.Second
+		}
+		cfg.retryBudget = &retryBudget{config: budget}
+		return nil
+	}
+}
+
+// retryBudget counts calls and retries over a sliding window, approximated by
+// weighting the previous fixed window by how much of it still overlaps.
+type retryBudget struct {
+	config RetryBudget
+
+	mu                     sync.Mutex
+	start                  time.Time
+	calls, retries         float64
+	prevCalls, prevRetries float64
+}
+
+func (b *retryBudget) recordCall() {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.advance(time.Now())
+	b.calls++
+}
+
+// withdraw reports whether a retry fits in the budget and, if so, records it.
+func (b *retryBudget) withdraw() bool {
+	if b == nil {
+		return true
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	weight := b.advance(time.Now())
+	calls := b.calls + b.prevCalls*weight
+	retries := b.retries + b.prevRetries*weight
+	if retries+1 > float64(b.config.MinRetries)+b.config.Ratio*calls {
+		return false
+	}
+	b.retries++
+	return true
+}
+
+// advance rolls the windows forward and returns the previous window's weight.
+func (b *retryBudget) advance(now time.Time) float64 {
+	elapsed := now.Sub(b.start)
+	switch {
+	case elapsed >= 2*b.config.Window:
+		b.start = now
+		b.calls, b.retries, b.prevCalls, b.prevRetries = 0, 0, 0, 0
+		elapsed = 0
+	case elapsed >= b.config.Window:
+		b.start = b.start.Add(b.config.Window)
+		b.prevCalls, b.prevRetries = b.calls, b.retries
+		b.calls, b.retries = 0, 0
+		elapsed -= b.config.Window
+	}
+	return 1 - float64(elapsed)/float64(b.config.Window)
+}
@@ -0,0 +1,310 @@
+package pandadoc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy_IdempotencyRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		method   string
+		path     string
+		status   int
+		policy   RetryPolicy
+		attempts int
+	}{
+		{name: "GET is retried", method: http.MethodGet, path: "/public/v1/documents", status: http.StatusServiceUnavailable, attempts: 2},
+		{name: "DELETE is retried", method: http.MethodDelete, path: "/public/v1/documents/doc1", status: http.StatusServiceUnavailable, attempts: 2},
+		{name: "POST is not retried on 5xx", method: http.MethodPost, path: "/public/v1/documents", status: http.StatusServiceUnavailable, attempts: 1},
+		{name: "POST is retried on 429", method: http.MethodPost, path: "/public/v1/documents", status: http.StatusTooManyRequests, attempts: 2},
+		{
+			name: "operation override", method: http.MethodPost, path: "/public/v1/documents", status: http.StatusServiceUnavailable, attempts: 2,
+			policy: RetryPolicy{Operations: map[string]RetryMode{"createDocument": RetryIdempotent}},
+		},
+		{
+			name: "method override", method: http.MethodGet, path: "/public/v1/documents", status: http.StatusServiceUnavailable, attempts: 1,
+			policy: RetryPolicy{Methods: map[string]RetryMode{"get": RetryNever}},
+		},
+		{
+			name: "operation beats method", method: http.MethodGet, path: "/public/v1/documents", status: http.StatusServiceUnavailable, attempts: 2,
+			policy: RetryPolicy{
+				Methods:    map[string]RetryMode{http.MethodGet: RetryNever},
+				Operations: map[string]RetryMode{"listDocuments": RetryIdempotent},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			policy := tt.policy
+			policy.MaxRetries, policy.InitialBackoff, policy.MaxBackoff = 1, 1, 1
+			policy.RetryOn429, policy.RetryOn5xx = true, true
+
+			attempts := 0
+			client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+				attempts++
+				w.WriteHeader(tt.status)
+			}, WithRetryPolicy(policy))
+
+			_, _ = client.do(context.Background(), &request{method: tt.method, path: tt.path, requireAuth: true}) //nolint:bodyclose // always an API error
+			if attempts != tt.attempts {
+				t.Fatalf("expected %d attempts, got %d", tt.attempts, attempts)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_TransportErrors(t *testing.T) {
+	t.Parallel()
+
+	policy := WithRetryPolicy(RetryPolicy{MaxRetries: 1, InitialBackoff: 1, MaxBackoff: 1})
+	countAttempts := func(attempts *int) Option {
+		return WithMiddleware(func(next Handler) Handler {
+			return func(ctx context.Context, call *Call) (*http.Response, error) {
+				if call.IsAttempt() {
+					*attempts++
+				}
+				return next(ctx, call)
+			}
+		})
+	}
+
+	var hangUps atomic.Int32
+	hangUp := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		hangUps.Add(1)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		if err == nil {
+			_ = conn.Close()
+		}
+	}, policy)
+	_, err := hangUp.do(context.Background(), &request{method: http.MethodPost, path: "/public/v1/documents", jsonBody: map[string]string{}, requireAuth: true}) //nolint:bodyclose // transport error
+	if err == nil || hangUps.Load() != 1 {
+		t.Fatalf("expected a sent POST not to be retried, got %d attempts and %v", hangUps.Load(), err)
+	}
+
+	srv := httptest.NewServer(http.NotFoundHandler())
+	srv.Close()
+	dialAttempts := 0
+	refused, err := NewClientWithAPIKey("k", WithBaseURL(srv.URL), policy, countAttempts(&dialAttempts))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	_, err = refused.do(context.Background(), &request{method: http.MethodPost, path: "/public/v1/documents", jsonBody: map[string]string{}, requireAuth: true}) //nolint:bodyclose // transport error
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) || dialAttempts != 2 {
+		t.Fatalf("expected an unsent POST to be retried, got %d attempts and %v", dialAttempts, err)
+	}
+}
+
+func TestRetryPolicy_IdempotencyKeysAndDecisions(t *testing.T) {
+	t.Parallel()
+
+	var keys []string
+	var decisions []RetryDecision
+	logger := &recordingLogger{}
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		w.WriteHeader(http.StatusBadGateway)
+	}
+	observe := WithMiddleware(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*http.Response, error) {
+			resp, err := next(ctx, call)
+			if call.IsAttempt() {
+				decisions = append(decisions, *call.Retry)
+			}
+			return resp, err
+		}
+	})
+	policy := WithRetryPolicy(RetryPolicy{MaxRetries: 1, InitialBackoff: 1, MaxBackoff: 1, RetryOn5xx: true})
+
+	client := newTestClient(t, handler, policy, observe, WithLogger(logger))
+	ctx := ContextWithIdempotencyKey(context.Background(), " key-1 ")
+	if _, err := client.Documents().Send(ctx, "doc1", DocumentSendRequest{}); !errors.As(err, new(*APIError)) {
+		t.Fatalf("expected API error, got %v", err)
+	}
+	want := []RetryDecision{{Retry: true, Reason: "status 502"}, {Reason: "status 502: retries exhausted"}}
+	if !slices.Equal(keys, []string{"key-1", "key-1"}) || !slices.Equal(decisions, want) {
+		t.Fatalf("unexpected keys %q or decisions %+v", keys, decisions)
+	}
+
+	keys, decisions = nil, nil
+	if _, err := client.Documents().Send(context.Background(), "doc1", DocumentSendRequest{}); !errors.As(err, new(*APIError)) {
+		t.Fatalf("expected API error, got %v", err)
+	}
+	want = []RetryDecision{{Reason: "status 502: POST request may have been sent"}}
+	if !slices.Equal(keys, []string{""}) || !slices.Equal(decisions, want) {
+		t.Fatalf("unexpected keys %q or decisions %+v", keys, decisions)
+	}
+	if !slices.ContainsFunc(logger.infos, func(s string) bool { return strings.Contains(s, "may have been sent") }) {
+		t.Fatalf("expected the decision to be logged, got %q", logger.infos)
+	}
+
+	keys = nil
+	setKey := WithMiddleware(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*http.Response, error) {
+			if !call.IsAttempt() {
+				call.IdempotencyKey = "from-middleware"
+			}
+			return next(ctx, call)
+		}
+	})
+	keyed := newTestClient(t, handler, policy, setKey)
+	_, _ = keyed.Documents().Send(context.Background(), "doc1", DocumentSendRequest{})
+	if !slices.Equal(keys, []string{"from-middleware", "from-middleware"}) {
+		t.Fatalf("expected middleware key on both attempts, got %q", keys)
+	}
+}
+
+func TestRetryPolicy_MiddlewareCopiesCall(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	copyCall := WithMiddleware(func(next Handler) Handler {
+		return func(ctx context.Context, call *Call) (*http.Response, error) {
+			clone := *call
+			return next(ctx, &clone)
+		}
+	})
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}, WithRetryPolicy(RetryPolicy{MaxRetries: 2, InitialBackoff: 1, MaxBackoff: 1, RetryOn5xx: true}), copyCall)
+
+	_, _ = client.do(context.Background(), &request{method: http.MethodGet, path: "/public/v1/documents", requireAuth: true}) //nolint:bodyclose // always an API error
+	if attempts != 3 {
+		t.Fatalf("expected retries regardless of the call passed down, got %d attempts", attempts)
+	}
+}
+
+func TestRetryPolicy_ValidationAndModes(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewClientWithAPIKey("k", WithRetryPolicy(RetryPolicy{Operations: map[string]RetryMode{"sendDocuments": RetryNever}}))
+	if !errors.Is(err, ErrUnknownOperation) {
+		t.Fatalf("expected ErrUnknownOperation, got %v", err)
+	}
+
+	p := RetryPolicy{}
+	if p.mode(http.MethodPut, "") != RetryIdempotent || p.mode(http.MethodPatch, "") != RetryUnsent {
+		t.Fatalf("unexpected default modes")
+	}
+	for mode, name := range map[RetryMode]string{RetryDefault: "default", RetryIdempotent: "idempotent", RetryUnsent: "unsent", RetryNever: "never", 9: "RetryMode(9)"} {
+		if mode.String() != name {
+			t.Fatalf("expected %s, got %s", name, mode)
+		}
+	}
+
+	if requestUnsent(io.ErrUnexpectedEOF, false, false) || !requestUnsent(io.ErrUnexpectedEOF, true, false) {
+		t.Fatalf("unexpected unsent detection")
+	}
+}
+
+func TestWithRetryBudget(t *testing.T) {
+	t.Parallel()
+
+	attempts := 0
+	logger := &recordingLogger{}
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	},
+		WithRetryPolicy(RetryPolicy{MaxRetries: 3, InitialBackoff: 1, MaxBackoff: 1, RetryOn5xx: true}),
+		WithRetryBudget(RetryBudget{MinRetries: 1, Ratio: 0.5, Window: time.Hour}),
+		WithLogger(logger),
+	)
+
+	for range 2 {
+		_, _ = client.do(context.Background(), &request{method: http.MethodGet, path: "/public/v1/documents", requireAuth: true}) //nolint:bodyclose // always an API error
+	}
+	// Two calls allow 1 + 0.5*2 = 2 retries in total.
+	if attempts != 4 {
+		t.Fatalf("expected the budget to cap retries at two, got %d attempts", attempts)
+	}
+	if !slices.ContainsFunc(logger.infos, func(s string) bool { return strings.Contains(s, "retry budget exhausted") }) {
+		t.Fatalf("expected the exhausted budget to be logged, got %q", logger.infos)
+	}
+
+	if _, err := NewClientWithAPIKey("k", WithRetryBudget(RetryBudget{Ratio: -1})); err == nil {
+		t.Fatalf("expected error for negative ratio")
+	}
+}
+
+func TestDecideRetry_ContextDone(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewClientWithAPIKey("k",
+		WithRetryPolicy(RetryPolicy{MaxRetries: 3, InitialBackoff: 1, MaxBackoff: 1, RetryOn5xx: true}),
+		WithRetryBudget(RetryBudget{MinRetries: 1}),
+	)
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	call := &Call{Method: http.MethodGet}
+	unavailable := &http.Response{StatusCode: http.StatusServiceUnavailable}
+
+	canceled, cancel := context.WithCancel(context.Background())
+	cancel()
+	if d := c.decideRetry(canceled, &preparedRequest{}, call, 0, unavailable, nil, true); d.Retry || d.Reason != "status 503: context done" {
+		t.Fatalf("expected a canceled context not to be retried, got %+v", d)
+	}
+	if d := c.decideRetry(context.Background(), &preparedRequest{}, call, 0, nil, context.DeadlineExceeded, false); d.Retry {
+		t.Fatalf("expected a deadline error not to be retried, got %+v", d)
+	}
+	if d := c.decideRetry(context.Background(), &preparedRequest{}, call, 0, unavailable, nil, true); !d.Retry {
+		t.Fatalf("expected the retry budget to be untouched by canceled attempts, got %+v", d)
+	}
+}
+
+func TestRetryBudget_SlidingWindow(t *testing.T) {
+	t.Parallel()
+
+	b := &retryBudget{config: RetryBudget{Ratio: 1, Window: time.Minute}}
+	for range 4 {
+		b.recordCall()
+	}
+	for range 4 {
+		if !b.withdraw() {
+			t.Fatalf("expected a retry per call")
+		}
+	}
+	if b.withdraw() {
+		t.Fatalf("expected the budget to be spent")
+	}
+
+	// Half-way through the next window, half of the previous one still counts.
+	b.start = b.start.Add(-90 * time.Second)
+	if weight := b.advance(b.start.Add(90 * time.Second)); weight < 0.49 || weight > 0.51 {
+		t.Fatalf("expected previous window weight 0.5, got %v", weight)
+	}
+	b.recordCall()
+	b.recordCall()
+	if !b.withdraw() {
+		t.Fatalf("expected new calls to refill the budget")
+	}
+
+	b.start = b.start.Add(-3 * time.Minute)
+	b.recordCall()
+	if b.prevCalls != 0 || b.calls != 1 {
+		t.Fatalf("expected stale windows to reset, got prev=%v cur=%v", b.prevCalls, b.calls)
+	}
+
+	var disabled *retryBudget
+	disabled.recordCall()
+	if !disabled.withdraw() {
+		t.Fatalf("expected no budget to allow retries")
+	}
+}
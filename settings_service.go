@@ -0,0 +1,116 @@
+package pandadoc
+
+import (
+	"context"
+	"net/http"
+)
+
+// settingsService implements SettingsService.
+type settingsService struct {
+	client *Client
+}
+
+// GetDocument returns v2 settings for a document.
+func (s *settingsService) GetDocument(ctx context.Context, documentID string) (*DocumentSettings, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out DocumentSettings
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v2/documents/" + escapedID + "/settings",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateDocument patches the document settings that are set in reqBody.
+func (s *settingsService) UpdateDocument(ctx context.Context, documentID string, reqBody *UpdateDocumentSettingsRequest) (*DocumentSettings, error) {
+	escapedID, err := escapePathParam(documentID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out DocumentSettings
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPatch,
+		path:        "/public/v2/documents/" + escapedID + "/settings",
+		requireAuth: true,
+		jsonBody:    reqBody,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// GetTemplate returns v2 settings for a template.
+func (s *settingsService) GetTemplate(ctx context.Context, templateID string) (*TemplateSettings, error) {
+	escapedID, err := escapePathParam(templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	var out TemplateSettings
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v2/templates/" + escapedID + "/settings",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateTemplate patches the template settings that are set in reqBody.
+func (s *settingsService) UpdateTemplate(ctx context.Context, templateID string, reqBody *UpdateTemplateSettingsRequest) (*TemplateSettings, error) {
+	escapedID, err := escapePathParam(templateID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out TemplateSettings
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPatch,
+		path:        "/public/v2/templates/" + escapedID + "/settings",
+		requireAuth: true,
+		jsonBody:    reqBody,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CompareDocumentWithTemplate reports how a document's settings differ from the template it was created from.
+func (s *settingsService) CompareDocumentWithTemplate(ctx context.Context, documentID string) ([]SettingDifference, error) {
+	details, err := s.client.Documents().Details(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if details.Template == nil || details.Template.ID == "" {
+		return nil, ErrDocumentHasNoTemplate
+	}
+
+	docSettings, err := s.GetDocument(ctx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	tplSettings, err := s.GetTemplate(ctx, details.Template.ID)
+	if err != nil {
+		return nil, err
+	}
+	return CompareSettings(docSettings, tplSettings), nil
+}
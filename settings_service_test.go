@@ -0,0 +1,117 @@
+package pandadoc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestSettingsService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v2/documents/doc1/settings":
+			_, _ = io.WriteString(w, `{"expires_in":30,"language":"de-DE","qualified_electronic_signature":false}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/public/v2/documents/doc1/settings":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"expires_in":14}` {
+				t.Fatalf("unexpected document patch: %s", body)
+			}
+			_, _ = io.WriteString(w, `{"expires_in":14,"language":"de-DE","qualified_electronic_signature":false}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v2/templates/tpl1/settings":
+			_, _ = io.WriteString(w, `{"language":"en-US"}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/public/v2/templates/tpl1/settings":
+			body, _ := io.ReadAll(r.Body)
+			if string(body) != `{"language":"fr-FR"}` {
+				t.Fatalf("unexpected template patch: %s", body)
+			}
+			_, _ = io.WriteString(w, `{"language":"fr-FR"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/doc1/details":
+			_, _ = io.WriteString(w, `{"id":"doc1","template":{"id":"tpl1"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/documents/blank/details":
+			_, _ = io.WriteString(w, `{"id":"blank"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.Settings()
+
+	doc, err := svc.GetDocument(ctx, "doc1")
+	if err != nil || doc.ExpiresInDays != 30 || doc.Language != DocumentLanguageGerman {
+		t.Fatalf("GetDocument failed: %v %+v", err, doc)
+	}
+
+	days := 14
+	updated, err := svc.UpdateDocument(ctx, "doc1", &UpdateDocumentSettingsRequest{ExpiresInDays: &days})
+	if err != nil || updated.ExpiresInDays != 14 {
+		t.Fatalf("UpdateDocument failed: %v %+v", err, updated)
+	}
+
+	tpl, err := svc.GetTemplate(ctx, "tpl1")
+	if err != nil || tpl.Language != DocumentLanguageEnglishUS {
+		t.Fatalf("GetTemplate failed: %v %+v", err, tpl)
+	}
+
+	lang := DocumentLanguageFrench
+	tplUpdated, err := svc.UpdateTemplate(ctx, "tpl1", &UpdateTemplateSettingsRequest{Language: &lang})
+	if err != nil || tplUpdated.Language != DocumentLanguageFrench {
+		t.Fatalf("UpdateTemplate failed: %v %+v", err, tplUpdated)
+	}
+
+	diffs, err := svc.CompareDocumentWithTemplate(ctx, "doc1")
+	if err != nil || len(diffs) != 1 || diffs[0].Field != "language" || diffs[0].Document != DocumentLanguageGerman || diffs[0].Template != DocumentLanguageEnglishUS {
+		t.Fatalf("CompareDocumentWithTemplate failed: %v %+v", err, diffs)
+	}
+
+	if _, err = svc.CompareDocumentWithTemplate(ctx, "blank"); !errors.Is(err, ErrDocumentHasNoTemplate) {
+		t.Fatalf("expected no template error, got %v", err)
+	}
+}
+
+func TestCompareSettings(t *testing.T) {
+	t.Parallel()
+
+	if diffs := CompareSettings(&DocumentSettings{Language: DocumentLanguageDutch}, &TemplateSettings{Language: DocumentLanguageDutch}); len(diffs) != 0 {
+		t.Fatalf("expected no differences, got %+v", diffs)
+	}
+	if diffs := CompareSettings(nil, &TemplateSettings{}); diffs != nil {
+		t.Fatalf("expected nil for missing document settings")
+	}
+	if diffs := CompareSettings(&DocumentSettings{}, nil); diffs != nil {
+		t.Fatalf("expected nil for missing template settings")
+	}
+}
+
+func TestSettingsService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	svc := client.Settings()
+
+	if _, err := svc.GetDocument(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+	if _, err := svc.GetTemplate(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+	if _, err := svc.UpdateDocument(ctx, "doc1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if _, err := svc.UpdateTemplate(ctx, "tpl1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if _, err := svc.CompareDocumentWithTemplate(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty path error, got %v", err)
+	}
+}
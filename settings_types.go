@@ -0,0 +1,92 @@
+package pandadoc
+
+// DocumentLanguage is the recipient-facing language of a document or template.
+type DocumentLanguage string
+
+// Supported document languages.
+const (
+	// DocumentLanguageEnglishUS is US English (en-US).
+	DocumentLanguageEnglishUS DocumentLanguage = "en-US"
+	// DocumentLanguageFrench is French (fr-FR).
+	DocumentLanguageFrench DocumentLanguage = "fr-FR"
+	// DocumentLanguageItalian is Italian (it-IT).
+	DocumentLanguageItalian DocumentLanguage = "it-IT"
+	// DocumentLanguageSpanish is Spanish (es-ES).
+	DocumentLanguageSpanish DocumentLanguage = "es-ES"
+	// DocumentLanguageDutch is Dutch (nl-NL).
+	DocumentLanguageDutch DocumentLanguage = "nl-NL"
+	// DocumentLanguageGerman is German (de-DE).
+	DocumentLanguageGerman DocumentLanguage = "de-DE"
+	// DocumentLanguagePortugueseBrazil is Brazilian Portuguese (pt-BR).
+	DocumentLanguagePortugueseBrazil DocumentLanguage = "pt-BR"
+	// DocumentLanguagePortuguesePortugal is European Portuguese (pt-PT).
+	DocumentLanguagePortuguesePortugal DocumentLanguage = "pt-PT"
+	// DocumentLanguagePolish is Polish (pl-PL).
+	DocumentLanguagePolish DocumentLanguage = "pl-PL"
+	// DocumentLanguageSwedish is Swedish (sv-SE).
+	DocumentLanguageSwedish DocumentLanguage = "sv-SE"
+	// DocumentLanguageCzech is Czech (cs-CZ).
+	DocumentLanguageCzech DocumentLanguage = "cs-CZ"
+	// DocumentLanguageDanish is Danish (da-DK).
+	DocumentLanguageDanish DocumentLanguage = "da-DK"
+	// DocumentLanguageGreek is Greek (el-GR).
+	DocumentLanguageGreek DocumentLanguage = "el-GR"
+	// DocumentLanguageHungarian is Hungarian (hu-HU).
+	DocumentLanguageHungarian DocumentLanguage = "hu-HU"
+	// DocumentLanguageNorwegian is Norwegian (nb-NO).
+	DocumentLanguageNorwegian DocumentLanguage = "nb-NO"
+	// DocumentLanguageRomanian is Romanian (ro-RO).
+	DocumentLanguageRomanian DocumentLanguage = "ro-RO"
+	// DocumentLanguageBulgarian is Bulgarian (bg-BG).
+	DocumentLanguageBulgarian DocumentLanguage = "bg-BG"
+)
+
+// DocumentSettings are the v2 settings of a document.
+type DocumentSettings struct {
+	ExpiresInDays                int              `json:"expires_in"`
+	Language                     DocumentLanguage `json:"language"`
+	QualifiedElectronicSignature bool             `json:"qualified_electronic_signature"`
+}
+
+// UpdateDocumentSettingsRequest partially updates document settings.
+//
+// Only non-nil fields are sent.
+type UpdateDocumentSettingsRequest struct {
+	ExpiresInDays                *int              `json:"expires_in,omitempty"`
+	Language                     *DocumentLanguage `json:"language,omitempty"`
+	QualifiedElectronicSignature *bool             `json:"qualified_electronic_signature,omitempty"`
+}
+
+// TemplateSettings are the v2 settings of a template.
+type TemplateSettings struct {
+	Language DocumentLanguage `json:"language"`
+}
+
+// UpdateTemplateSettingsRequest partially updates template settings.
+//
+// Only non-nil fields are sent.
+type UpdateTemplateSettingsRequest struct {
+	Language *DocumentLanguage `json:"language,omitempty"`
+}
+
+// SettingDifference describes one setting whose document value differs from its template.
+type SettingDifference struct {
+	Field    string
+	Document any
+	Template any
+}
+
+// CompareSettings reports settings shared by documents and templates whose values differ.
+//
+// Settings that only exist on documents (expiration, QES) have no template counterpart and are not compared.
+func CompareSettings(doc *DocumentSettings, tpl *TemplateSettings) []SettingDifference {
+	if doc == nil || tpl == nil {
+		return nil
+	}
+
+	var diffs []SettingDifference
+	if doc.Language != tpl.Language {
+		diffs = append(diffs, SettingDifference{Field: "language", Document: doc.Language, Template: tpl.Language})
+	}
+	return diffs
+}
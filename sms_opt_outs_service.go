@@ -0,0 +1,79 @@
+package pandadoc
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// defaultSMSOptOutWindow is the span of each request made by SMSOptOutsService.All.
+const defaultSMSOptOutWindow = 24 * time.Hour
+
+// smsOptOutsService implements SMSOptOutsService.
+type smsOptOutsService struct {
+	client *Client
+}
+
+// List lists the most recent SMS opt-out change for each phone number in the window.
+func (s *smsOptOutsService) List(ctx context.Context, opts *ListSMSOptOutsOptions) (*SMSOptOutListResponse, error) {
+	query := url.Values{}
+	if opts != nil {
+		if !opts.From.IsZero() {
+			query.Set("timestamp_from", opts.From.UTC().Format(time.RFC3339))
+		}
+		if !opts.To.IsZero() {
+			query.Set("timestamp_to", opts.To.UTC().Format(time.RFC3339))
+		}
+	}
+
+	var out SMSOptOutListResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/sms-opt-outs",
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// All iterates over SMS opt-out changes between from and to, one window per request.
+//
+// A zero to means now, a zero from means one hour before to, and a non-positive
+// window defaults to 24 hours. A phone number that changed in several windows is
+// yielded once per window, oldest window first. Iteration stops at the first
+// error, which is yielded once.
+func (s *smsOptOutsService) All(ctx context.Context, from, to time.Time, window time.Duration) iter.Seq2[SMSOptOutChange, error] {
+	if to.IsZero() {
+		to = time.Now()
+	}
+	if from.IsZero() {
+		from = to.Add(-time.Hour)
+	}
+	if window <= 0 {
+		window = defaultSMSOptOutWindow
+	}
+
+	return func(yield func(SMSOptOutChange, error) bool) {
+		for start := from; start.Before(to); start = start.Add(window) {
+			end := start.Add(window)
+			if end.After(to) {
+				end = to
+			}
+			resp, err := s.List(ctx, &ListSMSOptOutsOptions{From: start, To: end})
+			if err != nil {
+				yield(SMSOptOutChange{}, err)
+				return
+			}
+			for _, change := range resp.Results {
+				if !yield(change, nil) {
+					return
+				}
+			}
+		}
+	}
+}
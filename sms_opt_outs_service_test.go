@@ -0,0 +1,96 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestSMSOptOutsService_List(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/public/v1/sms-opt-outs" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		assertQueryEq(t, r.URL.Query(), "timestamp_from", "2025-01-28T00:00:00Z")
+		assertQueryEq(t, r.URL.Query(), "timestamp_to", "2025-01-28T23:59:59Z")
+		_, _ = io.WriteString(w, `{"results":[
+			{"phone_number":"+1234567890","status":"opt-out","opt_out_changed":"2025-01-28T12:00:00Z"},
+			{"phone_number":"+1234567891","status":"opt-in","opt_out_changed":"2025-01-28T12:00:00Z"},
+			{"phone_number":"+1234567891","status":"opt-out","opt_out_changed":"2025-01-28T09:00:00Z"}
+		]}`)
+	})
+
+	from := time.Date(2025, 1, 28, 0, 0, 0, 0, time.UTC)
+	resp, err := client.SMSOptOuts().List(context.Background(), &ListSMSOptOutsOptions{From: from, To: from.Add(24*time.Hour - time.Second)})
+	if err != nil || len(resp.Results) != 3 || resp.Results[0].Status != SMSOptOutStatusOptOut {
+		t.Fatalf("List failed: %v %+v", err, resp)
+	}
+	if !resp.Results[0].OptOutChanged.Equal(from.Add(12 * time.Hour)) {
+		t.Fatalf("unexpected change time: %v", resp.Results[0].OptOutChanged)
+	}
+	if !resp.IsOptedOut("+1234567890") || resp.IsOptedOut("+1234567891") || resp.IsOptedOut("+1000000000") {
+		t.Fatalf("unexpected opt-out lookup")
+	}
+	var missing *SMSOptOutListResponse
+	if missing.IsOptedOut("+1234567890") {
+		t.Fatalf("expected nil response not to report opt-outs")
+	}
+}
+
+func TestSMSOptOutsService_All(t *testing.T) {
+	t.Parallel()
+
+	var windows []string
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		windows = append(windows, q.Get("timestamp_from")+"/"+q.Get("timestamp_to"))
+		_, _ = io.WriteString(w, `{"results":[{"phone_number":"+1`+fmt.Sprint(len(windows))+`","status":"opt-out","opt_out_changed":"`+q.Get("timestamp_from")+`"}]}`)
+	})
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	var phones []string
+	for change, err := range client.SMSOptOuts().All(context.Background(), from, from.Add(60*time.Hour), 0) {
+		if err != nil {
+			t.Fatalf("All failed: %v", err)
+		}
+		phones = append(phones, change.PhoneNumber)
+	}
+	expected := "[2025-01-01T00:00:00Z/2025-01-02T00:00:00Z 2025-01-02T00:00:00Z/2025-01-03T00:00:00Z 2025-01-03T00:00:00Z/2025-01-03T12:00:00Z]"
+	if fmt.Sprint(windows) != expected || fmt.Sprint(phones) != "[+11 +12 +13]" {
+		t.Fatalf("unexpected windows=%v phones=%v", windows, phones)
+	}
+
+	for range client.SMSOptOuts().All(context.Background(), from, from.Add(60*time.Hour), 12*time.Hour) {
+		break
+	}
+	if len(windows) != 4 {
+		t.Fatalf("expected early break to stop requests, got %d", len(windows))
+	}
+
+	for range client.SMSOptOuts().All(context.Background(), time.Time{}, time.Time{}, 0) {
+		continue
+	}
+	if len(windows) != 5 {
+		t.Fatalf("expected a single default window, got %d", len(windows))
+	}
+
+	errClient := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = r
+		w.WriteHeader(http.StatusForbidden)
+	})
+	calls := 0
+	for _, err := range errClient.SMSOptOuts().All(context.Background(), from, from.Add(72*time.Hour), 0) {
+		calls++
+		if !IsForbidden(err) {
+			t.Fatalf("expected forbidden error, got %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Fatalf("expected error to be yielded once, got %d", calls)
+	}
+}
@@ -0,0 +1,53 @@
+package pandadoc
+
+import "time"
+
+// SMSOptOutStatus is whether a phone number accepts SMS delivery.
+type SMSOptOutStatus string
+
+// Supported SMS opt-out statuses.
+const (
+	// SMSOptOutStatusOptIn represents a number that accepts SMS delivery.
+	SMSOptOutStatusOptIn SMSOptOutStatus = "opt-in"
+	// SMSOptOutStatusOptOut represents a number that opted out of SMS delivery.
+	SMSOptOutStatusOptOut SMSOptOutStatus = "opt-out"
+)
+
+// ListSMSOptOutsOptions limits SMS opt-out changes to a time window.
+//
+// PandaDoc defaults From to one hour ago and To to now.
+type ListSMSOptOutsOptions struct {
+	From time.Time
+	To   time.Time
+}
+
+// SMSOptOutChange models the most recent opt-out change for a phone number.
+type SMSOptOutChange struct {
+	PhoneNumber   string          `json:"phone_number"`
+	Status        SMSOptOutStatus `json:"status"`
+	OptOutChanged time.Time       `json:"opt_out_changed"`
+}
+
+// SMSOptOutListResponse models list SMS opt-outs response.
+type SMSOptOutListResponse struct {
+	Results []SMSOptOutChange `json:"results"`
+}
+
+// IsOptedOut reports whether the latest change for phoneNumber is an opt-out.
+func (r *SMSOptOutListResponse) IsOptedOut(phoneNumber string) bool {
+	if r == nil {
+		return false
+	}
+
+	var latest *SMSOptOutChange
+	for i := range r.Results {
+		change := &r.Results[i]
+		if change.PhoneNumber != phoneNumber {
+			continue
+		}
+		if latest == nil || change.OptOutChanged.After(latest.OptOutChanged) {
+			latest = change
+		}
+	}
+	return latest != nil && latest.Status == SMSOptOutStatusOptOut
+}
@@ -0,0 +1,210 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// templatesService implements TemplatesService.
+type templatesService struct {
+	client *Client
+}
+
+// List lists/searches templates.
+func (s *templatesService) List(ctx context.Context, opts *ListTemplatesOptions) (*TemplateListResponse, error) {
+	query := url.Values{}
+	if opts != nil {
+		setIfNotEmpty(query, "q", opts.Q)
+		setIfNotNil(query, "shared", opts.Shared)
+		setIfNotNil(query, "deleted", opts.Deleted)
+		setIfPositive(query, "count", opts.Count)
+		setIfPositive(query, "page", opts.Page)
+		setIfNotEmpty(query, "id", opts.ID)
+		setIfNotEmpty(query, "folder_uuid", opts.FolderUUID)
+		for _, v := range opts.Tags {
+			query.Add("tag", v)
+		}
+		for _, v := range opts.Fields {
+			query.Add("fields", v)
+		}
+	}
+
+	var out TemplateListResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/templates",
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Create creates a template from a public file URL.
+func (s *templatesService) Create(ctx context.Context, reqBody *CreateTemplateRequest) (*TemplateCreateResponse, error) {
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out TemplateCreateResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPost,
+		path:           "/public/v1/templates",
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// CreateFromUpload creates a template from multipart upload payload.
+func (s *templatesService) CreateFromUpload(ctx context.Context, reqBody *CreateTemplateFromUploadRequest) (*TemplateCreateResponse, error) {
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+	if reqBody.File == nil {
+		return nil, ErrNilFileReader
+	}
+
+	fieldName := reqBody.FileField
+	if fieldName == "" {
+		fieldName = "file"
+	}
+
+	fields := make(map[string]string, len(reqBody.Fields)+1)
+	for k, v := range reqBody.Fields {
+		fields[k] = v
+	}
+	if reqBody.Data != nil {
+		data, err := json.Marshal(reqBody.Data)
+		if err != nil {
+			return nil, fmt.Errorf("encode template data: %w", err)
+		}
+		fields["data"] = string(data)
+	}
+
+	var out TemplateCreateResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/templates?upload",
+		requireAuth: true,
+		multipart: &multipartPayload{
+			Fields: fields,
+			Files: []multipartFile{{
+				FieldName:   fieldName,
+				FileName:    reqBody.FileName,
+				ContentType: reqBody.ContentType,
+				Reader:      reqBody.File,
+			}},
+		},
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Status returns a template status payload.
+func (s *templatesService) Status(ctx context.Context, id string) (*TemplateStatusResponse, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var out TemplateStatusResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/templates/" + escapedID,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Details returns template details.
+func (s *templatesService) Details(ctx context.Context, id string) (*TemplateDetailsResponse, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+
+	var out TemplateDetailsResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/templates/" + escapedID + "/details",
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Update updates a template and returns no payload on success.
+func (s *templatesService) Update(ctx context.Context, id string, reqBody *UpdateTemplateRequest) error {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return err
+	}
+	if reqBody == nil {
+		return ErrNilRequest
+	}
+
+	return s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPatch,
+		path:           "/public/v1/templates/" + escapedID,
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusNoContent},
+	}, nil)
+}
+
+// Delete deletes a template.
+func (s *templatesService) Delete(ctx context.Context, id string) error {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return err
+	}
+
+	return s.client.decodeJSON(ctx, &request{
+		method:         http.MethodDelete,
+		path:           "/public/v1/templates/" + escapedID,
+		requireAuth:    true,
+		expectedStatus: []int{http.StatusNoContent},
+	}, nil)
+}
+
+// CreateEditingSession creates an embedded editing session for a template.
+func (s *templatesService) CreateEditingSession(ctx context.Context, id string, reqBody *CreateTemplateEditingSessionRequest) (*CreateTemplateEditingSessionResponse, error) {
+	escapedID, err := escapePathParam(id)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out CreateTemplateEditingSessionResponse
+	err = s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPost,
+		path:           "/public/v1/templates/" + escapedID + "/editing-sessions",
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,299 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestTemplatesService_ListAllFilters(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/public/v1/templates" {
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		q := r.URL.Query()
+		assertQueryEq(t, q, "q", "nda")
+		assertQueryEq(t, q, "shared", "true")
+		assertQueryEq(t, q, "deleted", "false")
+		assertQueryEq(t, q, "count", "10")
+		assertQueryEq(t, q, "page", "2")
+		assertQueryEq(t, q, "id", "tpl1")
+		assertQueryEq(t, q, "folder_uuid", "f1")
+		assertQueryEq(t, q, "fields", "content_date_modified")
+		if len(q["tag"]) != 2 {
+			t.Fatalf("expected 2 tag values, got %v", q["tag"])
+		}
+		_, _ = io.WriteString(w, `{"results":[{"id":"tpl1","name":"NDA","version":"2"}]}`)
+	})
+
+	resp, err := client.Templates().List(context.Background(), &ListTemplatesOptions{
+		Q:          "nda",
+		Shared:     ptrBool(true),
+		Deleted:    ptrBool(false),
+		Count:      10,
+		Page:       2,
+		ID:         "tpl1",
+		FolderUUID: "f1",
+		Tags:       []string{"a", "b"},
+		Fields:     []string{"content_date_modified"},
+	})
+	if err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+	if len(resp.Results) != 1 || resp.Results[0].Name != "NDA" {
+		t.Fatalf("unexpected list response: %+v", resp)
+	}
+}
+
+//nolint:gocognit // Test function that validates all template methods
+func TestTemplatesService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/templates":
+			if r.URL.RawQuery != "" {
+				t.Fatalf("expected no query params, got %s", r.URL.RawQuery)
+			}
+			_, _ = io.WriteString(w, `{"results":[]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/templates":
+			var payload map[string]any
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode create payload: %v", err)
+			}
+			if payload["url"] != "https://example.com/t.pdf" {
+				t.Fatalf("unexpected create payload: %+v", payload)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id":"tpl1","name":"From URL"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/templates/tpl1":
+			_, _ = io.WriteString(w, `{"id":"tpl1","status":"template.PROCESSED"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/templates/tpl1/details":
+			_, _ = io.WriteString(w, `{
+				"id":"tpl1",
+				"roles":[{"id":"r1","name":"Signer","signing_order":"1","preassigned_person":{"type":"person","email":"a@example.com"}}],
+				"tokens":[{"name":"Client.Company","value":"Acme"}],
+				"fields":[{"name":"Date","type":"date"}],
+				"content_placeholders":[{"uuid":"cp1","block_id":"b1","description":"Terms"}],
+				"pricing":{"tables":[{"id":"pt1","name":"Pricing","currency":"USD","is_included_in_total":true,"items":[{"sku":"s1","qty":"2","discount":{"type":"percent","value":"10"}}]}],"total":"20.00"}
+			}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/public/v1/templates/tpl1":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodDelete && r.URL.Path == "/public/v1/templates/tpl1":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/templates/tpl1/editing-sessions":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id":"s1","token":"tok","template_id":"tpl1"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	if _, err := client.Templates().List(context.Background(), nil); err != nil {
+		t.Fatalf("List failed: %v", err)
+	}
+
+	created, err := client.Templates().Create(context.Background(), &CreateTemplateRequest{URL: "https://example.com/t.pdf", Name: "From URL"})
+	if err != nil || created.ID != "tpl1" {
+		t.Fatalf("Create failed: %v %+v", err, created)
+	}
+
+	status, err := client.Templates().Status(context.Background(), "tpl1")
+	if err != nil || status.Status != TemplateStatusProcessed {
+		t.Fatalf("Status failed: %v %+v", err, status)
+	}
+
+	details, err := client.Templates().Details(context.Background(), "tpl1")
+	if err != nil {
+		t.Fatalf("Details failed: %v", err)
+	}
+	if len(details.Roles) != 1 || details.Roles[0].PreassignedPerson == nil || details.Roles[0].PreassignedPerson.Email != "a@example.com" {
+		t.Fatalf("unexpected roles: %+v", details.Roles)
+	}
+	if len(details.Tokens) != 1 || details.Tokens[0].Value != "Acme" {
+		t.Fatalf("unexpected tokens: %+v", details.Tokens)
+	}
+	if len(details.Fields) != 1 || len(details.ContentPlaceholders) != 1 || details.ContentPlaceholders[0].BlockID != "b1" {
+		t.Fatalf("unexpected fields/placeholders: %+v", details)
+	}
+	if details.Pricing == nil || len(details.Pricing.Tables) != 1 || details.Pricing.Tables[0].Items[0].Discount.Value != "10" {
+		t.Fatalf("unexpected pricing: %+v", details.Pricing)
+	}
+
+	if err := client.Templates().Update(context.Background(), "tpl1", &UpdateTemplateRequest{Tokens: []TemplateToken{{Name: "a", Value: "b"}}}); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+
+	session, err := client.Templates().CreateEditingSession(context.Background(), "tpl1", &CreateTemplateEditingSessionRequest{Email: "a@example.com", Lifetime: 900})
+	if err != nil || session.TemplateID != "tpl1" {
+		t.Fatalf("CreateEditingSession failed: %v %+v", err, session)
+	}
+
+	if err := client.Templates().Delete(context.Background(), "tpl1"); err != nil {
+		t.Fatalf("Delete failed: %v", err)
+	}
+}
+
+//nolint:gocognit // Test function that validates multipart upload encoding
+func TestTemplatesService_CreateFromUpload(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/public/v1/templates" || !strings.Contains(r.URL.RawQuery, "upload") {
+			t.Fatalf("unexpected request %s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery)
+		}
+		mediaType, params, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil || mediaType != "multipart/form-data" {
+			t.Fatalf("expected multipart, got %s (%v)", mediaType, err)
+		}
+
+		mr := multipart.NewReader(r.Body, params["boundary"])
+		fields := make(map[string]string)
+		var fileField, fileName string
+		for {
+			part, err := mr.NextPart()
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			if err != nil {
+				t.Fatalf("read multipart: %v", err)
+			}
+			if part.FileName() != "" {
+				fileField = part.FormName()
+				fileName = part.FileName()
+				continue
+			}
+			b, _ := io.ReadAll(part)
+			fields[part.FormName()] = string(b)
+		}
+
+		if fileField != "file" || fileName != "template.pdf" {
+			t.Fatalf("unexpected file part: field=%s name=%s", fileField, fileName)
+		}
+		var data CreateTemplateRequest
+		if err := json.Unmarshal([]byte(fields["data"]), &data); err != nil || data.Name != "Uploaded" {
+			t.Fatalf("unexpected data field: %q (%v)", fields["data"], err)
+		}
+		if fields["extra"] != "x" {
+			t.Fatalf("missing extra field: %v", fields)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, `{"id":"tpl2","name":"Uploaded"}`)
+	})
+
+	created, err := client.Templates().CreateFromUpload(context.Background(), &CreateTemplateFromUploadRequest{
+		FileName: "template.pdf",
+		File:     strings.NewReader("pdf"),
+		Data:     &CreateTemplateRequest{Name: "Uploaded"},
+		Fields:   map[string]string{"extra": "x"},
+	})
+	if err != nil || created.ID != "tpl2" {
+		t.Fatalf("CreateFromUpload failed: %v %+v", err, created)
+	}
+}
+
+func TestTemplatesService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	if _, err := client.Templates().Create(context.Background(), nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected ErrNilRequest, got %v", err)
+	}
+	if _, err := client.Templates().CreateFromUpload(context.Background(), nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected ErrNilRequest, got %v", err)
+	}
+	if _, err := client.Templates().CreateFromUpload(context.Background(), &CreateTemplateFromUploadRequest{}); !errors.Is(err, ErrNilFileReader) {
+		t.Fatalf("expected ErrNilFileReader, got %v", err)
+	}
+	if _, err := client.Templates().CreateFromUpload(context.Background(), &CreateTemplateFromUploadRequest{
+		File: strings.NewReader("x"),
+		Data: &CreateTemplateRequest{Metadata: map[string]any{"bad": func() {}}},
+	}); err == nil {
+		t.Fatalf("expected data encode error")
+	}
+	if _, err := client.Templates().Status(context.Background(), ""); err == nil {
+		t.Fatalf("expected path param error")
+	}
+	if _, err := client.Templates().Details(context.Background(), ""); err == nil {
+		t.Fatalf("expected path param error")
+	}
+	if err := client.Templates().Update(context.Background(), "tpl1", nil); err == nil {
+		t.Fatalf("expected nil request")
+	}
+	if err := client.Templates().Update(context.Background(), "", &UpdateTemplateRequest{}); err == nil {
+		t.Fatalf("expected path param error")
+	}
+	if err := client.Templates().Delete(context.Background(), ""); err == nil {
+		t.Fatalf("expected path param error")
+	}
+	if _, err := client.Templates().CreateEditingSession(context.Background(), "tpl1", nil); err == nil {
+		t.Fatalf("expected nil request")
+	}
+	if _, err := client.Templates().CreateEditingSession(context.Background(), "", &CreateTemplateEditingSessionRequest{}); err == nil {
+		t.Fatalf("expected path param error")
+	}
+}
+
+func TestTemplatesService_ErrorPropagation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(w, `{"detail":"boom"}`)
+	})
+
+	type testCase struct {
+		name string
+		run  func() error
+	}
+
+	cases := []testCase{
+		{name: "List", run: func() error {
+			_, err := client.Templates().List(context.Background(), nil)
+			return err
+		}},
+		{name: "Create", run: func() error {
+			_, err := client.Templates().Create(context.Background(), &CreateTemplateRequest{URL: "u"})
+			return err
+		}},
+		{name: "CreateFromUpload", run: func() error {
+			_, err := client.Templates().CreateFromUpload(context.Background(), &CreateTemplateFromUploadRequest{File: strings.NewReader("x")})
+			return err
+		}},
+		{name: "Status", run: func() error {
+			_, err := client.Templates().Status(context.Background(), "tpl1")
+			return err
+		}},
+		{name: "Details", run: func() error {
+			_, err := client.Templates().Details(context.Background(), "tpl1")
+			return err
+		}},
+		{name: "CreateEditingSession", run: func() error {
+			_, err := client.Templates().CreateEditingSession(context.Background(), "tpl1", &CreateTemplateEditingSessionRequest{Email: "a@example.com"})
+			return err
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if err := tc.run(); err == nil {
+				t.Fatalf("expected error")
+			}
+		})
+	}
+}
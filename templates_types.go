@@ -0,0 +1,238 @@
+package pandadoc
+
+import "io"
+
+// TemplateStatus is the processing status of a template.
+type TemplateStatus string
+
+// Template status constants.
+const (
+	// TemplateStatusUploaded represents a template whose upload is still being processed.
+	TemplateStatusUploaded TemplateStatus = "template.UPLOADED"
+	// TemplateStatusProcessed represents a template that is ready for use.
+	TemplateStatusProcessed TemplateStatus = "template.PROCESSED"
+	// TemplateStatusError represents a template that failed processing.
+	TemplateStatusError TemplateStatus = "template.ERROR"
+)
+
+// ListTemplatesOptions controls template listing.
+type ListTemplatesOptions struct {
+	Q          string
+	Shared     *bool
+	Deleted    *bool
+	Count      int
+	Page       int
+	ID         string
+	FolderUUID string
+	Tags       []string
+	Fields     []string
+}
+
+// TemplateSummary represents core template fields used by list/create endpoints.
+type TemplateSummary struct {
+	ID                  string `json:"id,omitempty"`
+	Name                string `json:"name,omitempty"`
+	DateCreated         string `json:"date_created,omitempty"`
+	DateModified        string `json:"date_modified,omitempty"`
+	ContentDateModified string `json:"content_date_modified,omitempty"`
+	Version             string `json:"version,omitempty"`
+}
+
+// TemplateListResponse is returned by list templates endpoint.
+type TemplateListResponse struct {
+	Results []TemplateSummary `json:"results"`
+}
+
+// TemplateStatusResponse is returned by GET /templates/{id}.
+type TemplateStatusResponse struct {
+	ID           string         `json:"id,omitempty"`
+	Name         string         `json:"name,omitempty"`
+	Status       TemplateStatus `json:"status,omitempty"`
+	DateCreated  string         `json:"date_created,omitempty"`
+	DateModified string         `json:"date_modified,omitempty"`
+	Version      string         `json:"version,omitempty"`
+}
+
+// TemplateToken is a token name/value pair.
+type TemplateToken struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// TemplateOwner identifies the member that owns a new template.
+type TemplateOwner struct {
+	Email        string `json:"email,omitempty"`
+	MembershipID string `json:"membership_id,omitempty"`
+}
+
+// CreateTemplateRequest creates a template from a public file URL.
+type CreateTemplateRequest struct {
+	URL        string          `json:"url,omitempty"`
+	Name       string          `json:"name,omitempty"`
+	FolderUUID string          `json:"folder_uuid,omitempty"`
+	Owner      *TemplateOwner  `json:"owner,omitempty"`
+	Tokens     []TemplateToken `json:"tokens,omitempty"`
+	Metadata   map[string]any  `json:"metadata,omitempty"`
+}
+
+// CreateTemplateFromUploadRequest uploads a file and creates a template.
+//
+// Data, when set, is JSON-encoded into the multipart "data" field. ContentType,
+// when set, replaces the file part's Content-Type inferred from FileName.
+type CreateTemplateFromUploadRequest struct {
+	FileField   string
+	FileName    string
+	ContentType string
+	File        io.Reader
+	Data        *CreateTemplateRequest
+	Fields      map[string]string
+}
+
+// TemplateCreateResponse is returned when creating a template.
+type TemplateCreateResponse = TemplateSummary
+
+// UpdateTemplateRequest updates template tokens.
+type UpdateTemplateRequest struct {
+	Tokens []TemplateToken `json:"tokens,omitempty"`
+}
+
+// CreateTemplateEditingSessionRequest creates an embedded template editing session.
+type CreateTemplateEditingSessionRequest struct {
+	Email    string `json:"email"`
+	Lifetime int    `json:"lifetime,omitempty"`
+}
+
+// CreateTemplateEditingSessionResponse models template editing-session response.
+type CreateTemplateEditingSessionResponse struct {
+	ID         string `json:"id,omitempty"`
+	Token      string `json:"token,omitempty"`
+	Key        string `json:"key,omitempty"`
+	Email      string `json:"email,omitempty"`
+	ExpiresAt  string `json:"expires_at,omitempty"`
+	TemplateID string `json:"template_id,omitempty"`
+}
+
+// TemplateRole is a signer role defined by a template.
+type TemplateRole struct {
+	ID                string                     `json:"id,omitempty"`
+	Name              string                     `json:"name,omitempty"`
+	SigningOrder      string                     `json:"signing_order,omitempty"`
+	PreassignedPerson *TemplatePreassignedPerson `json:"preassigned_person,omitempty"`
+}
+
+// TemplatePreassignedPerson is the person or group preassigned to a template role.
+type TemplatePreassignedPerson struct {
+	Type              string                      `json:"type,omitempty"`
+	Email             string                      `json:"email,omitempty"`
+	FirstName         string                      `json:"first_name,omitempty"`
+	LastName          string                      `json:"last_name,omitempty"`
+	PlaceholderName   string                      `json:"placeholder_name,omitempty"`
+	PlaceholderSource string                      `json:"placeholder_source,omitempty"`
+	Members           []TemplatePreassignedMember `json:"members,omitempty"`
+}
+
+// TemplatePreassignedMember is a member of a preassigned group.
+type TemplatePreassignedMember struct {
+	Email     string `json:"email,omitempty"`
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+}
+
+// TemplateContentPlaceholder is a content placeholder block in a template.
+type TemplateContentPlaceholder struct {
+	UUID        string `json:"uuid,omitempty"`
+	BlockID     string `json:"block_id,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// TemplateImage is an image block in a template.
+type TemplateImage struct {
+	BlockUUID string   `json:"block_uuid,omitempty"`
+	Name      string   `json:"name,omitempty"`
+	URLs      []string `json:"urls,omitempty"`
+}
+
+// PricingAdjustment is a typed discount/tax value.
+type PricingAdjustment struct {
+	Type  string `json:"type,omitempty"`
+	Value string `json:"value,omitempty"`
+}
+
+// PricingTableItemOptions contains pricing-table row selection options.
+type PricingTableItemOptions struct {
+	Optional            *bool `json:"optional,omitempty"`
+	OptionalSelected    *bool `json:"optional_selected,omitempty"`
+	MultichoiceEnabled  *bool `json:"multichoice_enabled,omitempty"`
+	MultichoiceSelected *bool `json:"multichoice_selected,omitempty"`
+}
+
+// PricingTableItem is a row in a pricing table.
+type PricingTableItem struct {
+	ID            string                   `json:"id,omitempty"`
+	SKU           string                   `json:"sku,omitempty"`
+	Name          string                   `json:"name,omitempty"`
+	Description   string                   `json:"description,omitempty"`
+	Price         string                   `json:"price,omitempty"`
+	Cost          string                   `json:"cost,omitempty"`
+	Qty           string                   `json:"qty,omitempty"`
+	SalePrice     string                   `json:"sale_price,omitempty"`
+	Subtotal      string                   `json:"subtotal,omitempty"`
+	Discount      *PricingAdjustment       `json:"discount,omitempty"`
+	TaxFirst      *PricingAdjustment       `json:"tax_first,omitempty"`
+	TaxSecond     *PricingAdjustment       `json:"tax_second,omitempty"`
+	Options       *PricingTableItemOptions `json:"options,omitempty"`
+	CustomFields  RawJSON                  `json:"custom_fields,omitempty"`
+	CustomColumns RawJSON                  `json:"custom_columns,omitempty"`
+	MergedData    RawJSON                  `json:"merged_data,omitempty"`
+	Discounts     RawJSON                  `json:"discounts,omitempty"`
+	Fees          RawJSON                  `json:"fees,omitempty"`
+	Taxes         RawJSON                  `json:"taxes,omitempty"`
+}
+
+// PricingTableSummary contains pricing-table totals.
+type PricingTableSummary struct {
+	Discount string `json:"discount,omitempty"`
+	Fee      string `json:"fee,omitempty"`
+	Subtotal string `json:"subtotal,omitempty"`
+	Tax      string `json:"tax,omitempty"`
+	Total    string `json:"total,omitempty"`
+}
+
+// PricingTable is a pricing table in a template or document.
+type PricingTable struct {
+	ID                string               `json:"id,omitempty"`
+	Name              string               `json:"name,omitempty"`
+	Currency          string               `json:"currency,omitempty"`
+	IsIncludedInTotal bool                 `json:"is_included_in_total"`
+	Total             string               `json:"total,omitempty"`
+	Items             []PricingTableItem   `json:"items,omitempty"`
+	Summary           *PricingTableSummary `json:"summary,omitempty"`
+}
+
+// TemplatePricing contains pricing tables and quotes for a template, document, or content library item.
+type TemplatePricing struct {
+	Tables []PricingTable `json:"tables,omitempty"`
+	Quotes []Quote        `json:"quotes,omitempty"`
+	Total  string         `json:"total,omitempty"`
+}
+
+// TemplateDetailsResponse is returned by GET /templates/{id}/details.
+type TemplateDetailsResponse struct {
+	ID                  string                       `json:"id,omitempty"`
+	Name                string                       `json:"name,omitempty"`
+	Version             string                       `json:"version,omitempty"`
+	FolderUUID          string                       `json:"folder_uuid,omitempty"`
+	DateCreated         string                       `json:"date_created,omitempty"`
+	DateModified        string                       `json:"date_modified,omitempty"`
+	ContentDateModified string                       `json:"content_date_modified,omitempty"`
+	CreatedBy           *UserReference               `json:"created_by,omitempty"`
+	Metadata            map[string]any               `json:"metadata,omitempty"`
+	Tags                []string                     `json:"tags,omitempty"`
+	Roles               []TemplateRole               `json:"roles,omitempty"`
+	Tokens              []TemplateToken              `json:"tokens,omitempty"`
+	Fields              []DocumentField              `json:"fields,omitempty"`
+	Pricing             *TemplatePricing             `json:"pricing,omitempty"`
+	ContentPlaceholders []TemplateContentPlaceholder `json:"content_placeholders,omitempty"`
+	Images              []TemplateImage              `json:"images,omitempty"`
+	Tables              []NamedContentBlock          `json:"tables,omitempty"`
+}
@@ -33,6 +33,31 @@ func ptrBool(v bool) *bool {
 	return &v
 }
 
+func ptrFloat64(v float64) *float64 {
+	return &v
+}
+
+func ptrInt(v int) *int {
+	return &v
+}
+
+type recordingLogger struct {
+	infos []string
+}
+
+func (l *recordingLogger) Debugf(string, ...interface{}) {}
+
+func (l *recordingLogger) Infof(format string, args ...interface{}) {
+	l.infos = append(l.infos, format)
+	for _, a := range args {
+		if s, ok := a.(string); ok {
+			l.infos = append(l.infos, s)
+		}
+	}
+}
+
+func (l *recordingLogger) Errorf(string, ...interface{}) {}
+
 type roundTripperFunc func(*http.Request) (*http.Response, error)
 
 func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -6,11 +6,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
-	"mime/multipart"
 	"net/http"
+	"net/http/httptrace"
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 )
 
@@ -24,6 +25,9 @@ type request struct {
 	requireAuth bool
 	accept      string
 
+	// externalURL, when set, replaces path and is requested without credentials.
+	externalURL string
+
 	jsonBody  any
 	formBody  url.Values
 	multipart *multipartPayload
@@ -61,45 +65,107 @@ func (d *DownloadResponse) Close() error {
 	return d.Body.Close()
 }
 
+// preparedRequest is a request encoded once and replayed on every attempt.
+type preparedRequest struct {
+	req       *request
+	fullURL   string
+	body      encodedBody
+	lastDelay time.Duration
+}
+
+// encodedBody is a request body that is opened afresh for every attempt.
+type encodedBody struct {
+	data        []byte
+	stream      *multipartStream
+	contentType string
+}
+
+func (b encodedBody) empty() bool {
+	return len(b.data) == 0 && b.stream == nil
+}
+
+// replayable reports whether the body can be sent again after an attempt.
+func (b encodedBody) replayable() bool {
+	return b.stream == nil || b.stream.rewindable()
+}
+
+// length returns the body size in bytes, or -1 when it is not known up front.
+func (b encodedBody) length() int64 {
+	if b.stream != nil {
+		return b.stream.size
+	}
+	return int64(len(b.data))
+}
+
+func (b encodedBody) open() (io.ReadCloser, error) {
+	if b.stream != nil {
+		return b.stream.open()
+	}
+	return io.NopCloser(bytes.NewReader(b.data)), nil
+}
+
 func (c *Client) do(ctx context.Context, req *request) (*http.Response, error) {
 	if req == nil {
 		return nil, ErrNilRequest
 	}
 
-	fullURL, err := c.buildURL(req.path, req.query)
-	if err != nil {
-		return nil, err
+	call := &Call{Method: req.method, Path: req.path}
+	fullURL := req.externalURL
+	if fullURL == "" {
+		var err error
+		if fullURL, err = c.buildURL(req.path, req.query); err != nil {
+			return nil, err
+		}
+		call.OperationID = operationID(req.method, req.path)
+		call.IdempotencyKey = idempotencyKeyFromContext(ctx)
+	} else {
+		call.Path = req.externalURL
 	}
 
-	bodyBytes, contentType, err := encodeRequestBody(req)
+	call.RetryMode = c.retryPolicy.mode(call.Method, call.OperationID)
+
+	body, err := encodeRequestBody(req)
 	if err != nil {
 		return nil, err
 	}
 
-	attempt := 0
-	for {
-		ok, resp, err := c.doAttemptWithHandling(ctx, req, fullURL, bodyBytes, contentType, attempt)
-		if err != nil {
-			return nil, err
-		}
-		if ok {
-			return resp, nil
+	prepared := &preparedRequest{req: req, fullURL: fullURL, body: body}
+	c.retryBudget.recordCall()
+	logical := c.withMiddleware(func(ctx context.Context, call *Call) (*http.Response, error) {
+		for attempt := 0; ; attempt++ {
+			ok, resp, err := c.doAttemptWithHandling(ctx, prepared, call, attempt)
+			if err != nil {
+				return nil, err
+			}
+			if ok {
+				return resp, nil
+			}
 		}
-		attempt++
-	}
+	})
+	return logical(ctx, call)
+}
+
+// nextDelay returns the backoff before the next attempt of p.
+func (c *Client) nextDelay(p *preparedRequest, attempt int) time.Duration {
+	p.lastDelay = c.retryDelay(attempt, p.lastDelay)
+	return p.lastDelay
 }
 
-func (c *Client) doAttemptWithHandling(ctx context.Context, req *request, fullURL string, bodyBytes []byte, contentType string, attempt int) (bool, *http.Response, error) {
-	c.logDebug("API Request: %s %s (attempt %d)", req.method, fullURL, attempt+1)
+func (c *Client) doAttemptWithHandling(ctx context.Context, p *preparedRequest, call *Call, attempt int) (bool, *http.Response, error) {
+	req := p.req
+	c.logDebug("API Request: %s %s (attempt %d)", req.method, p.fullURL, attempt+1)
 
-	resp, retryable, err := c.doAttempt(ctx, req, fullURL, bodyBytes, contentType)
+	resp, decision, err := c.doAttempt(ctx, p, call, attempt)
 	if err != nil {
 		c.logError("Request failed: %v", err)
-		if !retryable || !c.shouldRetryOnError(attempt, err) {
+		if !decision.Retry {
+			if decision.Reason != "" {
+				c.logInfo("Not retrying %s (%s mode): %s", call.OperationID, c.retryMode(call), decision.Reason)
+			}
 			return false, nil, err
 		}
 		c.logInfo("Retrying after error: %v", err)
-		if sleepErr := sleepWithContext(ctx, c.backoff(attempt)); sleepErr != nil {
+		if sleepErr := sleepWithContext(ctx, c.nextDelay(p, attempt)); sleepErr != nil {
 			return false, nil, sleepErr
 		}
 		return false, nil, nil
@@ -107,8 +173,8 @@ func (c *Client) doAttemptWithHandling(ctx context.Context, req *request, fullUR
 
 	c.logDebug("API Response: %d %s", resp.StatusCode, resp.Status)
 
-	if c.shouldRetryOnStatus(attempt, resp) {
-		retryDelay := c.backoff(attempt)
+	if decision.Retry {
+		retryDelay := c.nextDelay(p, attempt)
 		if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
 			retryDelay = retryAfter
 		}
@@ -120,6 +186,9 @@ func (c *Client) doAttemptWithHandling(ctx context.Context, req *request, fullUR
 		}
 		return false, nil, nil
 	}
+	if decision.Reason != "" {
+		c.logInfo("Not retrying %s (%s mode): %s", call.OperationID, c.retryMode(call), decision.Reason)
+	}
 
 	if !statusExpected(resp.StatusCode, req.expectedStatus) {
 		apiErr := parseAPIError(resp)
@@ -131,15 +200,28 @@ func (c *Client) doAttemptWithHandling(ctx context.Context, req *request, fullUR
 	return true, resp, nil
 }
 
-func (c *Client) doAttempt(ctx context.Context, req *request, fullURL string, bodyBytes []byte, contentType string) (*http.Response, bool, error) {
-	httpReq, buildErr := http.NewRequestWithContext(ctx, req.method, fullURL, bytes.NewReader(bodyBytes))
-	if buildErr != nil {
-		return nil, false, fmt.Errorf("build request: %w", buildErr)
+// doAttempt performs one attempt; errors before sending come with a no-retry decision.
+func (c *Client) doAttempt(ctx context.Context, p *preparedRequest, call *Call, attempt int) (*http.Response, *RetryDecision, error) {
+	req := p.req
+	noRetry := &RetryDecision{}
+	waited, waitErr := c.rateLimiter.wait(ctx, call.OperationID)
+	if waitErr != nil {
+		return nil, noRetry, waitErr
+	}
+	if waited > 0 {
+		c.logDebug("Rate limiter delayed %s by %v", call.OperationID, waited)
 	}
 
-	if len(bodyBytes) > 0 {
-		httpReq.Header.Set("Content-Type", contentType)
+	var gotConn, wroteHeaders atomic.Bool
+	traceCtx := httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		GotConn:      func(httptrace.GotConnInfo) { gotConn.Store(true) },
+		WroteHeaders: func() { wroteHeaders.Store(true) },
+	})
+	httpReq, buildErr := http.NewRequestWithContext(traceCtx, req.method, p.fullURL, nil)
+	if buildErr != nil {
+		return nil, noRetry, fmt.Errorf("build request: %w", buildErr)
 	}
+
 	accept := req.accept
 	if accept == "" {
 		accept = "application/json"
@@ -153,12 +235,50 @@ func (c *Client) doAttempt(ctx context.Context, req *request, fullURL string, bo
 		}
 	}
 
-	if err := c.injectAuth(httpReq, req.requireAuth); err != nil {
-		return nil, false, err
+	if req.externalURL == "" {
+		if err := c.injectAuth(httpReq, req.requireAuth); err != nil {
+			return nil, noRetry, err
+		}
+		if call.IdempotencyKey != "" {
+			httpReq.Header.Set(IdempotencyKeyHeader, call.IdempotencyKey)
+		}
+	}
+
+	if !p.body.empty() {
+		body, err := p.body.open()
+		if err != nil {
+			return nil, noRetry, err
+		}
+		defer func() { _ = body.Close() }()
+		httpReq.Body = body
+		httpReq.GetBody = p.body.open
+		httpReq.ContentLength = p.body.length()
+		httpReq.Header.Set("Content-Type", p.body.contentType)
+	}
+
+	attemptCall := *call
+	attemptCall.Attempt = attempt + 1
+	attemptCall.Request = httpReq
+	// The decision is captured here rather than read back from attemptCall,
+	// since middleware may hand the next handler a different *Call.
+	decision := noRetry
+	send := func(ctx context.Context, call *Call) (*http.Response, error) {
+		resp, err := c.send(ctx, call)
+		if err == nil {
+			c.rateLimiter.observe(call.OperationID, resp)
+		}
+		sent := err == nil || !requestUnsent(err, gotConn.Load(), wroteHeaders.Load())
+		decision = c.decideRetry(ctx, p, call, attempt, resp, err, sent)
+		call.Retry = decision
+		return resp, err
 	}
+	resp, doErr := c.withMiddleware(send)(ctx, &attemptCall)
+	return resp, decision, doErr
+}
 
-	resp, doErr := c.httpClient.Do(httpReq)
-	return resp, true, doErr
+// send performs one physical attempt; it is the innermost attempt handler.
+func (c *Client) send(_ context.Context, call *Call) (*http.Response, error) {
+	return c.httpClient.Do(call.Request)
 }
 
 func (c *Client) decodeJSON(ctx context.Context, req *request, out any) error {
@@ -224,12 +344,15 @@ func (c *Client) shouldRetryOnStatus(attempt int, resp *http.Response) bool {
 	if attempt >= c.retryPolicy.MaxRetries || resp == nil {
 		return false
 	}
+	return c.retryableStatus(resp.StatusCode)
+}
 
-	if resp.StatusCode == http.StatusTooManyRequests && c.retryPolicy.RetryOn429 {
+func (c *Client) retryableStatus(status int) bool {
+	if status == http.StatusTooManyRequests && c.retryPolicy.RetryOn429 {
 		return true
 	}
 
-	if resp.StatusCode >= 500 && resp.StatusCode <= 599 && c.retryPolicy.RetryOn5xx {
+	if status >= 500 && status <= 599 && c.retryPolicy.RetryOn5xx {
 		return true
 	}
 
@@ -237,17 +360,7 @@ func (c *Client) shouldRetryOnStatus(attempt int, resp *http.Response) bool {
 }
 
 func (c *Client) backoff(attempt int) time.Duration {
-	if attempt <= 0 {
-		return c.retryPolicy.InitialBackoff
-	}
-	backoff := c.retryPolicy.InitialBackoff
-	for i := 0; i < attempt; i++ {
-		backoff *= 2
-		if backoff >= c.retryPolicy.MaxBackoff {
-			return c.retryPolicy.MaxBackoff
-		}
-	}
-	return backoff
+	return exponentialDelay(c.retryPolicy.InitialBackoff, c.retryPolicy.MaxBackoff, attempt)
 }
 
 var errEndpointPathRequired = fmt.Errorf("endpoint path is required")
@@ -315,7 +428,7 @@ func joinPaths(basePath, relPath string) string {
 	return trimmedBase + "/" + relPath
 }
 
-func encodeRequestBody(req *request) ([]byte, string, error) {
+func encodeRequestBody(req *request) (encodedBody, error) {
 	bodyKinds := 0
 	if req.jsonBody != nil {
 		bodyKinds++
@@ -327,67 +440,28 @@ func encodeRequestBody(req *request) ([]byte, string, error) {
 		bodyKinds++
 	}
 	if bodyKinds > 1 {
-		return nil, "", errOnlyOneBodyType
+		return encodedBody{}, errOnlyOneBodyType
 	}
 
 	if req.jsonBody != nil {
 		payload, err := json.Marshal(req.jsonBody)
 		if err != nil {
-			return nil, "", fmt.Errorf("encode JSON request body: %w", err)
+			return encodedBody{}, fmt.Errorf("encode JSON request body: %w", err)
 		}
-		return payload, "application/json", nil
+		return encodedBody{data: payload, contentType: "application/json"}, nil
 	}
 	if req.formBody != nil {
-		return []byte(req.formBody.Encode()), "application/x-www-form-urlencoded", nil
+		return encodedBody{data: []byte(req.formBody.Encode()), contentType: "application/x-www-form-urlencoded"}, nil
 	}
 	if req.multipart != nil {
-		payload, contentType, err := encodeMultipart(req.multipart)
+		stream, err := newMultipartStream(req.multipart)
 		if err != nil {
-			return nil, "", err
+			return encodedBody{}, err
 		}
-		return payload, contentType, nil
-	}
-
-	return nil, "", nil
-}
-
-func encodeMultipart(payload *multipartPayload) ([]byte, string, error) {
-	var body bytes.Buffer
-	writer := multipart.NewWriter(&body)
-
-	for k, v := range payload.Fields {
-		if err := writer.WriteField(k, v); err != nil {
-			return nil, "", fmt.Errorf("write multipart field %q: %w", k, err)
-		}
-	}
-
-	for _, file := range payload.Files {
-		if file.Reader == nil {
-			return nil, "", ErrNilFileReader
-		}
-		field := file.FieldName
-		if field == "" {
-			field = "file"
-		}
-		fileName := file.FileName
-		if fileName == "" {
-			fileName = "upload.bin"
-		}
-
-		part, err := writer.CreateFormFile(field, fileName)
-		if err != nil {
-			return nil, "", fmt.Errorf("create multipart file %q: %w", field, err)
-		}
-		if _, err := io.Copy(part, file.Reader); err != nil {
-			return nil, "", fmt.Errorf("copy multipart file %q: %w", field, err)
-		}
-	}
-
-	if err := writer.Close(); err != nil {
-		return nil, "", fmt.Errorf("close multipart body: %w", err)
+		return encodedBody{stream: stream, contentType: stream.contentType()}, nil
 	}
 
-	return body.Bytes(), writer.FormDataContentType(), nil
+	return encodedBody{}, nil
 }
 
 func statusExpected(status int, expected []int) bool {
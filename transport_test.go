@@ -64,23 +64,25 @@ func TestBuildURL_MergesQuery(t *testing.T) {
 func TestEncodeRequestBody(t *testing.T) {
 	t.Parallel()
 
-	payload, ct, err := encodeRequestBody(&request{jsonBody: map[string]string{"a": "b"}})
-	if err != nil || ct != "application/json" || !strings.Contains(string(payload), "\"a\":\"b\"") {
-		t.Fatalf("json body encoding failed: ct=%s err=%v payload=%s", ct, err, payload)
+	body, err := encodeRequestBody(&request{jsonBody: map[string]string{"a": "b"}})
+	if err != nil || body.contentType != "application/json" || !strings.Contains(string(body.data), "\"a\":\"b\"") {
+		t.Fatalf("json body encoding failed: ct=%s err=%v payload=%s", body.contentType, err, body.data)
 	}
 
-	payload, ct, err = encodeRequestBody(&request{formBody: url.Values{"x": []string{"1"}}})
-	if err != nil || ct != "application/x-www-form-urlencoded" || string(payload) != "x=1" {
-		t.Fatalf("form encoding failed: ct=%s err=%v payload=%s", ct, err, payload)
+	body, err = encodeRequestBody(&request{formBody: url.Values{"x": []string{"1"}}})
+	if err != nil || body.contentType != "application/x-www-form-urlencoded" || string(body.data) != "x=1" {
+		t.Fatalf("form encoding failed: ct=%s err=%v payload=%s", body.contentType, err, body.data)
 	}
 
-	payload, ct, err = encodeRequestBody(&request{multipart: &multipartPayload{
+	body, err = encodeRequestBody(&request{multipart: &multipartPayload{
 		Fields: map[string]string{"f": "v"},
 		Files:  []multipartFile{{FieldName: "file", FileName: "a.txt", Reader: strings.NewReader("hello")}},
 	}})
 	if err != nil {
 		t.Fatalf("multipart encoding failed: %v", err)
 	}
+	ct := body.contentType
+	payload := readEncodedBody(t, body)
 	if !strings.HasPrefix(ct, "multipart/form-data;") {
 		t.Fatalf("unexpected content-type: %s", ct)
 	}
@@ -98,11 +100,11 @@ func TestEncodeRequestBody(t *testing.T) {
 		t.Fatalf("unexpected first part field: %s", part.FormName())
 	}
 
-	if _, _, err := encodeRequestBody(&request{jsonBody: map[string]any{}, formBody: url.Values{}}); err == nil {
+	if _, err := encodeRequestBody(&request{jsonBody: map[string]any{}, formBody: url.Values{}}); err == nil {
 		t.Fatalf("expected body-type conflict error")
 	}
 
-	if _, _, err := encodeRequestBody(&request{multipart: &multipartPayload{Files: []multipartFile{{FieldName: "f", Reader: nil}}}}); !errors.Is(err, ErrNilFileReader) {
+	if _, err := encodeRequestBody(&request{multipart: &multipartPayload{Files: []multipartFile{{FieldName: "f", Reader: nil}}}}); !errors.Is(err, ErrNilFileReader) {
 		t.Fatalf("expected ErrNilFileReader, got %v", err)
 	}
 }
@@ -382,7 +384,7 @@ func TestDoRequest_BuildRequestFailure(t *testing.T) {
 func TestEncodeRequestBody_JSONMarshalFailure(t *testing.T) {
 	t.Parallel()
 
-	_, _, err := encodeRequestBody(&request{jsonBody: map[string]any{"x": func() {}}})
+	_, err := encodeRequestBody(&request{jsonBody: map[string]any{"x": func() {}}})
 	if err == nil {
 		t.Fatalf("expected marshal failure")
 	}
@@ -656,14 +658,15 @@ func TestDoAttempt_InjectAuthError(t *testing.T) {
 		t.Fatalf("NewClient failed: %v", err)
 	}
 
-	resp, retryable, err := c.doAttempt(context.Background(), &request{method: http.MethodGet, requireAuth: true}, "https://api.example.com/x", nil, "")
+	prepared := &preparedRequest{req: &request{method: http.MethodGet, requireAuth: true}, fullURL: "https://api.example.com/x"}
+	resp, decision, err := c.doAttempt(context.Background(), prepared, &Call{Method: http.MethodGet, Path: "/x"}, 0)
 	if resp != nil {
 		_ = resp.Body.Close()
 	}
 	if !errors.Is(err, ErrMissingAuthentication) {
 		t.Fatalf("expected ErrMissingAuthentication, got %v", err)
 	}
-	if retryable {
+	if decision.Retry {
 		t.Fatalf("did not expect auth error to be retryable")
 	}
 }
@@ -744,18 +747,19 @@ func TestDownload_ErrorPath(t *testing.T) {
 	}
 }
 
-func TestEncodeMultipart_DefaultsAndErrors(t *testing.T) {
+func TestMultipartStream_DefaultsAndErrors(t *testing.T) {
 	t.Parallel()
 
-	payload, contentType, err := encodeMultipart(&multipartPayload{
+	stream, err := newMultipartStream(&multipartPayload{
 		Files: []multipartFile{{
 			Reader: strings.NewReader("data"),
 		}},
 	})
 	if err != nil {
-		t.Fatalf("encodeMultipart defaults failed: %v", err)
+		t.Fatalf("newMultipartStream defaults failed: %v", err)
 	}
-	_, params, err := mime.ParseMediaType(contentType)
+	payload := readEncodedBody(t, encodedBody{stream: stream})
+	_, params, err := mime.ParseMediaType(stream.contentType())
 	if err != nil {
 		t.Fatalf("parse media type failed: %v", err)
 	}
@@ -764,21 +768,45 @@ func TestEncodeMultipart_DefaultsAndErrors(t *testing.T) {
 	if err != nil {
 		t.Fatalf("read multipart part failed: %v", err)
 	}
-	if part.FormName() != "file" || part.FileName() != "upload.bin" {
-		t.Fatalf("unexpected multipart defaults: form=%s file=%s", part.FormName(), part.FileName())
+	if part.FormName() != "file" || part.FileName() != "upload.bin" || part.Header.Get("Content-Type") != "application/octet-stream" {
+		t.Fatalf("unexpected multipart defaults: form=%s file=%s type=%s", part.FormName(), part.FileName(), part.Header.Get("Content-Type"))
 	}
 
-	if _, _, err := encodeMultipart(&multipartPayload{
+	stream, err = newMultipartStream(&multipartPayload{
 		Files: []multipartFile{{
 			FieldName: "file",
 			FileName:  "x.txt",
 			Reader:    newErrorReader(),
 		}},
-	}); err == nil {
+	})
+	if err != nil {
+		t.Fatalf("newMultipartStream failed: %v", err)
+	}
+	rc, err := stream.open()
+	if err != nil {
+		t.Fatalf("open failed: %v", err)
+	}
+	defer func() { _ = rc.Close() }()
+	if _, err := io.ReadAll(rc); err == nil {
 		t.Fatalf("expected multipart copy error")
 	}
 }
 
+func readEncodedBody(t *testing.T, body encodedBody) []byte {
+	t.Helper()
+
+	rc, err := body.open()
+	if err != nil {
+		t.Fatalf("open body failed: %v", err)
+	}
+	defer func() { _ = rc.Close() }()
+	payload, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatalf("read body failed: %v", err)
+	}
+	return payload
+}
+
 func TestStatusExpectedAndExtractErrorDetails(t *testing.T) {
 	t.Parallel()
 
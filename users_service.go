@@ -0,0 +1,55 @@
+package pandadoc
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+// usersService implements UsersService.
+type usersService struct {
+	client *Client
+}
+
+// List lists organization users.
+func (s *usersService) List(ctx context.Context, opts *ListUsersOptions) (*UserListResponse, error) {
+	query := url.Values{}
+	if opts != nil {
+		setIfPositive(query, "count", opts.Count)
+		setIfPositive(query, "page", opts.Page)
+		setIfNotNil(query, "show_removed", opts.ShowRemoved)
+	}
+
+	var out UserListResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/users",
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Create creates an organization user and adds them to workspaces.
+func (s *usersService) Create(ctx context.Context, reqBody *CreateUserRequest, notify *MemberNotificationOptions) (*CreateUserResponse, error) {
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out CreateUserResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPost,
+		path:           "/public/v1/users",
+		query:          notify.query(),
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
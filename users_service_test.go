@@ -0,0 +1,61 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestUsersService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/users":
+			assertQueryEq(t, r.URL.Query(), "count", "50")
+			assertQueryEq(t, r.URL.Query(), "show_removed", "true")
+			_, _ = io.WriteString(w, `{"results":[{"user_id":"u1","email":"jane@example.com","license":"Full","is_organization_owner":true,"workspaces":[{"membership_id":"m1","workspace_id":"ws1","role":"Admin"}]}],"total":1}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/users":
+			assertQueryEq(t, r.URL.Query(), "notify_user", "true")
+			var payload struct {
+				User       map[string]any   `json:"user"`
+				License    string           `json:"license"`
+				Workspaces []map[string]any `json:"workspaces"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+			if payload.User["email"] != "new@example.com" || payload.License != "eSign" || payload.Workspaces[0]["role"] != "Member" {
+				t.Fatalf("unexpected create payload: %+v", payload)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"user_id":"u2","workspaces":[{"member_id":"m2","workspace_id":"ws1","role":"Member"}]}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.Users()
+
+	list, err := svc.List(ctx, &ListUsersOptions{Count: 50, ShowRemoved: ptrBool(true)})
+	if err != nil || len(list.Results) != 1 || !list.Results[0].IsOrganizationOwner || list.Results[0].Workspaces[0].Role != WorkspaceRoleAdmin {
+		t.Fatalf("List failed: %v %+v", err, list)
+	}
+
+	created, err := svc.Create(ctx, &CreateUserRequest{
+		User:       NewUser{Email: "new@example.com", FirstName: "New"},
+		License:    UserLicenseESign,
+		Workspaces: []UserWorkspaceAssignment{{WorkspaceID: "ws1", Role: WorkspaceRoleMember}},
+	}, &MemberNotificationOptions{NotifyUser: ptrBool(true)})
+	if err != nil || created.UserID != "u2" || created.Workspaces[0].MemberID != "m2" {
+		t.Fatalf("Create failed: %v %+v", err, created)
+	}
+
+	if _, err = svc.Create(ctx, nil, nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+}
@@ -0,0 +1,77 @@
+package pandadoc
+
+// UserLicense is the license type assigned to an organization user.
+type UserLicense string
+
+// Supported user licenses.
+const (
+	// UserLicenseFull is a full PandaDoc license.
+	UserLicenseFull UserLicense = "Full"
+	// UserLicenseESign is an eSign license.
+	UserLicenseESign UserLicense = "eSign"
+	// UserLicenseReadOnly is a read-only license.
+	UserLicenseReadOnly UserLicense = "Read-only"
+	// UserLicenseCreator is a creator license.
+	UserLicenseCreator UserLicense = "Creator"
+	// UserLicenseGuest is a guest license.
+	UserLicenseGuest UserLicense = "Guest"
+)
+
+// ListUsersOptions controls list users query params.
+type ListUsersOptions struct {
+	Count       int
+	Page        int
+	ShowRemoved *bool
+}
+
+// UserMembership models a user's role in one workspace.
+type UserMembership struct {
+	MembershipID string        `json:"membership_id,omitempty"`
+	WorkspaceID  string        `json:"workspace_id,omitempty"`
+	Role         WorkspaceRole `json:"role,omitempty"`
+}
+
+// User models an organization user.
+type User struct {
+	UserID              string           `json:"user_id"`
+	Email               string           `json:"email,omitempty"`
+	FirstName           string           `json:"first_name,omitempty"`
+	LastName            string           `json:"last_name,omitempty"`
+	PhoneNumber         string           `json:"phone_number,omitempty"`
+	License             UserLicense      `json:"license,omitempty"`
+	IsOrganizationOwner bool             `json:"is_organization_owner"`
+	Workspaces          []UserMembership `json:"workspaces,omitempty"`
+}
+
+// UserListResponse models list users response.
+type UserListResponse struct {
+	Results []User `json:"results"`
+	Total   int    `json:"total,omitempty"`
+}
+
+// NewUser holds the profile of a user to create.
+type NewUser struct {
+	Email       string `json:"email"`
+	FirstName   string `json:"first_name,omitempty"`
+	LastName    string `json:"last_name,omitempty"`
+	PhoneNumber string `json:"phone_number,omitempty"`
+}
+
+// UserWorkspaceAssignment places a new user in a workspace.
+type UserWorkspaceAssignment struct {
+	WorkspaceID string        `json:"workspace_id"`
+	Role        WorkspaceRole `json:"role"`
+}
+
+// CreateUserRequest models create user payload.
+type CreateUserRequest struct {
+	User       NewUser                   `json:"user"`
+	License    UserLicense               `json:"license"`
+	Workspaces []UserWorkspaceAssignment `json:"workspaces"`
+}
+
+// CreateUserResponse models create user response.
+type CreateUserResponse struct {
+	UserID     string            `json:"user_id"`
+	Workspaces []WorkspaceMember `json:"workspaces,omitempty"`
+}
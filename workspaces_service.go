@@ -0,0 +1,147 @@
+package pandadoc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// workspacesService implements WorkspacesService.
+type workspacesService struct {
+	client *Client
+}
+
+// List lists organization workspaces.
+func (s *workspacesService) List(ctx context.Context, opts *ListWorkspacesOptions) (*WorkspaceListResponse, error) {
+	query := url.Values{}
+	if opts != nil {
+		setIfPositive(query, "count", opts.Count)
+		setIfPositive(query, "page", opts.Page)
+	}
+
+	var out WorkspaceListResponse
+	err := s.client.decodeJSON(ctx, &request{
+		method:      http.MethodGet,
+		path:        "/public/v1/workspaces",
+		query:       query,
+		requireAuth: true,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Create creates a workspace.
+func (s *workspacesService) Create(ctx context.Context, reqBody *CreateWorkspaceRequest) (*Workspace, error) {
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out Workspace
+	err := s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPost,
+		path:           "/public/v1/workspaces",
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// Deactivate deactivates a workspace.
+func (s *workspacesService) Deactivate(ctx context.Context, workspaceID string) error {
+	escapedID, err := escapePathParam(workspaceID)
+	if err != nil {
+		return err
+	}
+
+	return s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/workspaces/" + escapedID + "/deactivate",
+		requireAuth: true,
+		jsonBody:    struct{}{},
+	}, nil)
+}
+
+// AddMember adds an existing user to a workspace.
+func (s *workspacesService) AddMember(ctx context.Context, workspaceID string, reqBody *AddWorkspaceMemberRequest, notify *MemberNotificationOptions) (*WorkspaceMember, error) {
+	escapedID, err := escapePathParam(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out WorkspaceMember
+	err = s.client.decodeJSON(ctx, &request{
+		method:         http.MethodPost,
+		path:           "/public/v1/workspaces/" + escapedID + "/members",
+		query:          notify.query(),
+		requireAuth:    true,
+		jsonBody:       reqBody,
+		expectedStatus: []int{http.StatusCreated},
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RemoveMember removes a member from a workspace.
+func (s *workspacesService) RemoveMember(ctx context.Context, workspaceID, memberID string) error {
+	escapedID, err := escapePathParam(workspaceID)
+	if err != nil {
+		return err
+	}
+	escapedMemberID, err := escapePathParam(memberID)
+	if err != nil {
+		return fmt.Errorf("member id: %w", err)
+	}
+
+	return s.client.decodeJSON(ctx, &request{
+		method:         http.MethodDelete,
+		path:           "/public/v1/workspaces/" + escapedID + "/members/" + escapedMemberID,
+		requireAuth:    true,
+		expectedStatus: []int{http.StatusNoContent},
+	}, nil)
+}
+
+// CreateAPIKey creates an API key for a workspace.
+//
+// Use APIKey.NewClient to start calling the API with the new key.
+func (s *workspacesService) CreateAPIKey(ctx context.Context, workspaceID string, reqBody *CreateAPIKeyRequest) (*APIKey, error) {
+	escapedID, err := escapePathParam(workspaceID)
+	if err != nil {
+		return nil, err
+	}
+	if reqBody == nil {
+		return nil, ErrNilRequest
+	}
+
+	var out APIKey
+	err = s.client.decodeJSON(ctx, &request{
+		method:      http.MethodPost,
+		path:        "/public/v1/workspaces/" + escapedID + "/api-keys",
+		requireAuth: true,
+		jsonBody:    reqBody,
+	}, &out)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (n *MemberNotificationOptions) query() url.Values {
+	query := url.Values{}
+	if n != nil {
+		setIfNotNil(query, "notify_user", n.NotifyUser)
+		setIfNotNil(query, "notify_ws_admins", n.NotifyWorkspaceAdmins)
+	}
+	return query
+}
@@ -0,0 +1,165 @@
+package pandadoc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+func TestWorkspacesService_AllMethods(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]any
+		if r.Method == http.MethodPost {
+			if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+				t.Fatalf("decode payload: %v", err)
+			}
+		}
+
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/public/v1/workspaces":
+			assertQueryEq(t, r.URL.Query(), "count", "10")
+			assertQueryEq(t, r.URL.Query(), "page", "2")
+			_, _ = io.WriteString(w, `{"results":[{"id":"ws1","name":"Acme","owner":"owner@example.com","date_created":"2026-01-01T00:00:00Z"}],"total":11}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/workspaces":
+			if payload["name"] != "Acme" {
+				t.Fatalf("unexpected create payload: %+v", payload)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"id":"ws1","name":"Acme"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/workspaces/ws1/deactivate":
+			_, _ = io.WriteString(w, `{}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/workspaces/ws1/members":
+			assertQueryEq(t, r.URL.Query(), "notify_user", "false")
+			assertQueryEq(t, r.URL.Query(), "notify_ws_admins", "true")
+			if payload["user_id"] != "u1" || payload["role"] != "Manager" {
+				t.Fatalf("unexpected member payload: %+v", payload)
+			}
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"member_id":"m1","workspace_id":"ws1","email":"jane@example.com","role":"Manager"}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/public/v1/workspaces/ws1/members/m1":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/public/v1/workspaces/ws1/api-keys":
+			if payload["type"] != "sandbox" || payload["user_id"] != "u1" {
+				t.Fatalf("unexpected api key payload: %+v", payload)
+			}
+			_, _ = io.WriteString(w, `{"key":"new-key","type":"sandbox","user_id":"u1","workspace_id":"ws1"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	svc := client.Workspaces()
+
+	list, err := svc.List(ctx, &ListWorkspacesOptions{Count: 10, Page: 2})
+	if err != nil || list.Total != 11 || list.Results[0].Owner != "owner@example.com" {
+		t.Fatalf("List failed: %v %+v", err, list)
+	}
+
+	ws, err := svc.Create(ctx, &CreateWorkspaceRequest{Name: "Acme"})
+	if err != nil || ws.ID != "ws1" {
+		t.Fatalf("Create failed: %v %+v", err, ws)
+	}
+
+	if err = svc.Deactivate(ctx, "ws1"); err != nil {
+		t.Fatalf("Deactivate failed: %v", err)
+	}
+
+	member, err := svc.AddMember(ctx, "ws1", &AddWorkspaceMemberRequest{UserID: "u1", Role: WorkspaceRoleManager},
+		&MemberNotificationOptions{NotifyUser: ptrBool(false), NotifyWorkspaceAdmins: ptrBool(true)})
+	if err != nil || member.MemberID != "m1" || member.Role != WorkspaceRoleManager {
+		t.Fatalf("AddMember failed: %v %+v", err, member)
+	}
+
+	if err = svc.RemoveMember(ctx, "ws1", "m1"); err != nil {
+		t.Fatalf("RemoveMember failed: %v", err)
+	}
+
+	key, err := svc.CreateAPIKey(ctx, "ws1", &CreateAPIKeyRequest{Type: APIKeyTypeSandbox, UserID: "u1"})
+	if err != nil || key.Key != "new-key" || key.Type != APIKeyTypeSandbox {
+		t.Fatalf("CreateAPIKey failed: %v %+v", err, key)
+	}
+}
+
+func TestAPIKey_NewClient(t *testing.T) {
+	t.Parallel()
+
+	admin := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/public/v1/workspaces/ws1/api-keys":
+			_, _ = io.WriteString(w, `{"key":"tenant-key","type":"production","workspace_id":"ws1"}`)
+		case "/public/v1/members/current":
+			if got := r.Header.Get("Authorization"); got != "API-Key tenant-key" {
+				t.Fatalf("unexpected tenant auth header: %q", got)
+			}
+			_, _ = io.WriteString(w, `{"workspace":"ws1"}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	ctx := context.Background()
+	key, err := admin.Workspaces().CreateAPIKey(ctx, "ws1", &CreateAPIKeyRequest{Type: APIKeyTypeProduction})
+	if err != nil {
+		t.Fatalf("CreateAPIKey failed: %v", err)
+	}
+
+	tenant, err := key.NewClient(WithBaseURL(admin.baseURL.String()))
+	if err != nil {
+		t.Fatalf("NewClient failed: %v", err)
+	}
+	me, err := tenant.Members().Current(ctx)
+	if err != nil || me.Workspace != "ws1" {
+		t.Fatalf("Current failed: %v %+v", err, me)
+	}
+
+	var missing *APIKey
+	if _, err = missing.NewClient(); !errors.Is(err, ErrMissingAuthentication) {
+		t.Fatalf("expected missing auth error, got %v", err)
+	}
+	if _, err = (&APIKey{}).NewClient(); !errors.Is(err, ErrMissingAuthentication) {
+		t.Fatalf("expected missing auth error, got %v", err)
+	}
+}
+
+func TestWorkspacesService_Validation(t *testing.T) {
+	t.Parallel()
+
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = w
+		_ = r
+		t.Fatalf("handler should not be called")
+	})
+
+	ctx := context.Background()
+	svc := client.Workspaces()
+	if _, err := svc.Create(ctx, nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if err := svc.Deactivate(ctx, ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty id error, got %v", err)
+	}
+	if _, err := svc.AddMember(ctx, "", &AddWorkspaceMemberRequest{}, nil); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty id error, got %v", err)
+	}
+	if _, err := svc.AddMember(ctx, "ws1", nil, nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+	if err := svc.RemoveMember(ctx, "", "m1"); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty workspace id error, got %v", err)
+	}
+	if err := svc.RemoveMember(ctx, "ws1", ""); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty member id error, got %v", err)
+	}
+	if _, err := svc.CreateAPIKey(ctx, "", &CreateAPIKeyRequest{}); !errors.Is(err, ErrEmptyPathParameter) {
+		t.Fatalf("expected empty id error, got %v", err)
+	}
+	if _, err := svc.CreateAPIKey(ctx, "ws1", nil); !errors.Is(err, ErrNilRequest) {
+		t.Fatalf("expected nil request error, got %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package pandadoc
+
+// WorkspaceRole is a member role within a workspace.
+type WorkspaceRole string
+
+// Supported workspace roles.
+const (
+	// WorkspaceRoleAdmin manages the workspace and its members.
+	WorkspaceRoleAdmin WorkspaceRole = "Admin"
+	// WorkspaceRoleManager manages content and documents in the workspace.
+	WorkspaceRoleManager WorkspaceRole = "Manager"
+	// WorkspaceRoleMember creates and sends documents.
+	WorkspaceRoleMember WorkspaceRole = "Member"
+	// WorkspaceRoleCollaborator collaborates on documents shared with them.
+	WorkspaceRoleCollaborator WorkspaceRole = "Collaborator"
+)
+
+// APIKeyType distinguishes production and sandbox API keys.
+type APIKeyType string
+
+// Supported API key types.
+const (
+	// APIKeyTypeProduction is a production API key.
+	APIKeyTypeProduction APIKeyType = "production"
+	// APIKeyTypeSandbox is a sandbox API key for testing.
+	APIKeyTypeSandbox APIKeyType = "sandbox"
+)
+
+// ListWorkspacesOptions controls list workspaces query params.
+type ListWorkspacesOptions struct {
+	Count int
+	Page  int
+}
+
+// Workspace models an organization workspace.
+type Workspace struct {
+	ID          string `json:"id"`
+	Name        string `json:"name,omitempty"`
+	Owner       string `json:"owner,omitempty"`
+	DateCreated string `json:"date_created,omitempty"`
+}
+
+// WorkspaceListResponse models list workspaces response.
+type WorkspaceListResponse struct {
+	Results []Workspace `json:"results"`
+	Total   int         `json:"total,omitempty"`
+}
+
+// CreateWorkspaceRequest models create workspace payload.
+type CreateWorkspaceRequest struct {
+	Name string `json:"name"`
+}
+
+// MemberNotificationOptions controls who is emailed when a user joins a workspace.
+type MemberNotificationOptions struct {
+	NotifyUser            *bool
+	NotifyWorkspaceAdmins *bool
+}
+
+// AddWorkspaceMemberRequest models add workspace member payload.
+type AddWorkspaceMemberRequest struct {
+	UserID string        `json:"user_id"`
+	Role   WorkspaceRole `json:"role"`
+}
+
+// WorkspaceMember models a user's membership in a workspace.
+type WorkspaceMember struct {
+	MemberID    string        `json:"member_id"`
+	WorkspaceID string        `json:"workspace_id,omitempty"`
+	Email       string        `json:"email,omitempty"`
+	FirstName   string        `json:"first_name,omitempty"`
+	LastName    string        `json:"last_name,omitempty"`
+	Role        WorkspaceRole `json:"role,omitempty"`
+}
+
+// CreateAPIKeyRequest models create workspace API key payload.
+//
+// UserID defaults to the caller when empty.
+type CreateAPIKeyRequest struct {
+	Type   APIKeyType `json:"type"`
+	UserID string     `json:"user_id,omitempty"`
+}
+
+// APIKey models a newly created workspace API key.
+type APIKey struct {
+	Key         string     `json:"key"`
+	Type        APIKeyType `json:"type,omitempty"`
+	UserID      string     `json:"user_id,omitempty"`
+	WorkspaceID string     `json:"workspace_id,omitempty"`
+}
+
+// NewClient creates a client authenticated with this API key.
+//
+// Sandbox keys need the sandbox base URL passed via WithBaseURL.
+func (k *APIKey) NewClient(opts ...Option) (*Client, error) {
+	if k == nil || k.Key == "" {
+		return nil, ErrMissingAuthentication
+	}
+	return NewClientWithAPIKey(k.Key, opts...)
+}